@@ -1,24 +1,45 @@
 package api
 
 import (
+	"context"
+	"os"
 	"time"
 
 	"github.com/spf13/viper"
 	"github.com/zalando/go-keyring"
 
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
 	"github.com/ankitpokhrel/jira-cli/pkg/netrc"
 )
 
-const clientTimeout = 15 * time.Second
+// dialTimeout is the time allowed to establish a connection with the server. The overall
+// duration of each request is bounded by the timeout set in the config, if any.
+const dialTimeout = 15 * time.Second
 
-var jiraClient *jira.Client
+var (
+	jiraClient  *jira.Client
+	clientCtx   = context.Background()
+	clientCache jira.Cache
+)
+
+// SetContext sets the context used by the client for all the requests.
+// Cancelling the context aborts the in-flight requests.
+func SetContext(ctx context.Context) {
+	clientCtx = ctx
+}
+
+// SetCache sets the cache used by the client to store the responses
+// if the cache is enabled in the config, see cachePolicy.
+func SetCache(c jira.Cache) {
+	clientCache = c
+}
 
 // Client initializes and returns jira client.
 func Client(config jira.Config) *jira.Client {
 	if jiraClient != nil {
-		return jiraClient
+		return jiraClient.WithContext(clientCtx)
 	}
 
 	if config.Server == "" {
@@ -61,13 +82,75 @@ func Client(config jira.Config) *jira.Client {
 		config.MTLSConfig.ClientKey = viper.GetString("mtls.client_key")
 	}
 
-	jiraClient = jira.NewClient(
-		config,
-		jira.WithTimeout(clientTimeout),
+	opts := []jira.ClientFunc{
+		jira.WithTimeout(dialTimeout),
+		jira.WithRequestTimeout(viper.GetDuration("timeout")),
 		jira.WithInsecureTLS(*config.Insecure),
-	)
+		jira.WithRetry(retryPolicy()),
+	}
+
+	if clientCache != nil && viper.GetBool("cache.enabled") {
+		opts = append(opts, jira.WithCache(clientCache, cachePolicy()))
+	}
+
+	// The dump file is kept open for the lifetime of the client
+	// which is same as the lifetime of the command.
+	if debugFile := viper.GetString("debug_file"); debugFile != "" {
+		f, err := os.OpenFile(debugFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			cmdutil.Failed("Error: unable to open debug file: %s", err)
+		}
+		config.Debug = true
+		opts = append(opts, jira.WithDebugWriter(f))
+	}
+
+	jiraClient = jira.NewClient(config, opts...)
+
+	return jiraClient.WithContext(clientCtx)
+}
+
+// retryPolicy returns the retry policy configured in the config file. Defaults are
+// used for the values that are not set, and setting max retries to 0 disables it.
+//
+//	retry:
+//	  max_retries: 3
+//	  min_backoff: 500ms
+//	  max_backoff: 30s
+func retryPolicy() jira.RetryPolicy {
+	p := jira.DefaultRetryPolicy()
+
+	if viper.IsSet("retry.max_retries") {
+		p.MaxRetries = viper.GetInt("retry.max_retries")
+	}
+	if viper.IsSet("retry.min_backoff") {
+		p.MinBackoff = viper.GetDuration("retry.min_backoff")
+	}
+	if viper.IsSet("retry.max_backoff") {
+		p.MaxBackoff = viper.GetDuration("retry.max_backoff")
+	}
+
+	return p
+}
+
+// cachePolicy returns the cache policy configured in the config file. Defaults
+// are used for the values that are not set, and setting a ttl to 0 disables it.
+//
+//	cache:
+//	  enabled: true
+//	  ttl:
+//	    meta: 24h
+//	    project: 1h
+//	    transitions: 5m
+func cachePolicy() jira.CachePolicy {
+	p := jira.DefaultCachePolicy()
+
+	for _, class := range []jira.CacheClass{jira.CacheClassMeta, jira.CacheClassProject, jira.CacheClassTransitions} {
+		if key := "cache.ttl." + string(class); viper.IsSet(key) {
+			p.TTL[class] = viper.GetDuration(key)
+		}
+	}
 
-	return jiraClient
+	return p
 }
 
 // DefaultClient returns default jira client.
@@ -127,21 +210,46 @@ func ProxyGetIssue(c *jira.Client, key string, opts ...filter.Filter) (*jira.Iss
 // ProxySearch uses either a v2 or v3 version of the Jira GET /search endpoint
 // to search for the relevant issues based on configured installation type.
 // Defaults to v3 if installation type is not defined in the config.
+//
+// The v3 endpoint doesn't support offsets, so the result pages are followed
+// using the page token until the requested window of issues is collected.
+// A zero limit collects all matching issues.
 func ProxySearch(c *jira.Client, jql string, from, limit uint) (*jira.SearchResult, error) {
-	var (
-		issues *jira.SearchResult
-		err    error
-	)
+	it := ProxySearchIterator(c, jql, from, limit)
+
+	issues, err := it.All()
+	if err != nil {
+		return nil, err
+	}
 
+	return &jira.SearchResult{
+		IsLast: true,
+		Issues: issues,
+	}, nil
+}
+
+// ProxySearchCount uses either a v2 or v3 version of the Jira search endpoints to count
+// the issues matching the JQL based on configured installation type. The count is
+// approximate in v3. Defaults to v3 if installation type is not defined in the config.
+func ProxySearchCount(c *jira.Client, jql string) (int, error) {
 	it := viper.GetString("installation")
 
 	if it == jira.InstallationTypeLocal {
-		issues, err = c.SearchV2(jql, from, limit)
-	} else {
-		issues, err = c.Search(jql, limit)
+		return c.SearchCountV2(jql)
 	}
+	return c.SearchCount(jql)
+}
 
-	return issues, err
+// ProxySearchIterator returns an iterator over either a v2 or v3 version of the Jira
+// GET /search endpoint based on configured installation type. A zero limit iterates
+// over all matching issues. Defaults to v3 if installation type is not defined in the config.
+func ProxySearchIterator(c *jira.Client, jql string, from, limit uint) *jira.SearchIterator {
+	it := viper.GetString("installation")
+
+	if it == jira.InstallationTypeLocal {
+		return c.SearchIteratorV2(jql, from, limit)
+	}
+	return c.SearchIterator(jql, from, limit)
 }
 
 // ProxyAssignIssue uses either a v2 or v3 version of the PUT /issue/{key}/assignee
@@ -228,3 +336,53 @@ func ProxyWatchIssue(c *jira.Client, key string, user *jira.User) error {
 	}
 	return c.WatchIssue(key, assignee)
 }
+
+// ProxyEdit uses either a v2 or v3 version of the PUT /issue/{key} endpoint to edit
+// an issue based on configured installation type. The body is expected to be in
+// Jira wiki markup format for v2 and in markdown format for v3.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyEdit(c *jira.Client, key string, req *jira.EditRequest) error {
+	it := viper.GetString("installation")
+
+	if it == jira.InstallationTypeLocal {
+		return c.Edit(key, req)
+	}
+	return c.EditV3(key, req)
+}
+
+// ProxyAddIssueComment uses either a v2 or v3 version of the POST /issue/{key}/comment
+// endpoint to add a comment to an issue based on configured installation type.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyAddIssueComment(c *jira.Client, key, comment string, internal bool) error {
+	it := viper.GetString("installation")
+
+	if it == jira.InstallationTypeLocal {
+		return c.AddIssueComment(key, comment, internal)
+	}
+	return c.AddIssueCommentV3(key, comment, internal)
+}
+
+// ProxyUpdateIssueComment uses either a v2 or v3 version of the PUT /issue/{key}/comment/{id}
+// endpoint to update a comment based on configured installation type.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyUpdateIssueComment(c *jira.Client, key, id, comment string) error {
+	it := viper.GetString("installation")
+
+	if it == jira.InstallationTypeLocal {
+		return c.UpdateIssueComment(key, id, comment)
+	}
+	return c.UpdateIssueCommentV3(key, id, comment)
+}
+
+// ProxyGetIssueChangelog fetches complete change history of an issue using either
+// the v3 GET /issue/{key}/changelog endpoint or the v2 GET /issue/{key} endpoint with
+// the changelog expanded based on configured installation type.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyGetIssueChangelog(c *jira.Client, key string) ([]*jira.ChangelogHistory, error) {
+	it := viper.GetString("installation")
+
+	if it == jira.InstallationTypeLocal {
+		return c.GetIssueChangelogV2(key)
+	}
+	return c.GetAllIssueChangelog(key)
+}
@@ -1,15 +1,25 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/root"
 )
 
 func main() {
+	// Cancel the in-flight requests on interrupt. The default behavior is restored
+	// afterward so that a second interrupt terminates the program immediately.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
 	rootCmd := root.NewCmdRoot()
-	if _, err := rootCmd.ExecuteC(); err != nil {
+	if _, err := rootCmd.ExecuteContextC(ctx); err != nil {
 		fmt.Fprintf(os.Stderr, "%s\n", err)
 		os.Exit(1)
 	}
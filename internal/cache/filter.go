@@ -0,0 +1,365 @@
+package cache
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const valueEmpty = "x"
+
+var relativePeriod = regexp.MustCompile(`^([-+]?)(\d+)([wdhm])$`)
+
+// IssueFilter filters the cached issues using the same parameters
+// as the query that is sent to the server by the issue list command.
+type IssueFilter struct {
+	params *query.IssueParams
+	text   string
+	now    time.Time
+}
+
+// NewIssueFilter constructs a new issue filter. The text, if any, is
+// searched in the key, summary, description and the comments of the issues.
+func NewIssueFilter(params *query.IssueParams, text string) *IssueFilter {
+	return &IssueFilter{
+		params: params,
+		text:   strings.ToLower(strings.TrimSpace(text)),
+		now:    time.Now(),
+	}
+}
+
+// Apply filters and orders the issues.
+func (f *IssueFilter) Apply(issues []*jira.Issue) ([]*jira.Issue, error) {
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+
+	var out []*jira.Issue
+	for _, iss := range issues {
+		ok, err := f.match(iss)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, iss)
+		}
+	}
+
+	if err := f.order(out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (f *IssueFilter) validate() error {
+	unsupported := func(flag string) error {
+		return fmt.Errorf("the %s filter is not supported in the offline mode", flag)
+	}
+
+	switch {
+	case f.params.JQL != "":
+		return unsupported("--jql")
+	case f.params.Latest:
+		return unsupported("--history")
+	}
+	return nil
+}
+
+func (f *IssueFilter) match(iss *jira.Issue) (bool, error) {
+	fields := iss.Fields
+
+	parent := ""
+	if fields.Parent != nil {
+		parent = fields.Parent.Key
+	}
+	components := make([]string, 0, len(fields.Components))
+	for _, c := range fields.Components {
+		components = append(components, c.Name)
+	}
+
+	if f.params.Watching && !fields.Watches.IsWatching {
+		return false, nil
+	}
+
+	if !matchValue(f.params.IssueType, fields.IssueType.Name) ||
+		!matchValue(f.params.Resolution, fields.Resolution.Name) ||
+		!matchValue(f.params.Priority, fields.Priority.Name) ||
+		!matchValue(f.params.Reporter, fields.Reporter.Name) ||
+		!matchValue(f.params.Assignee, fields.Assignee.Name) ||
+		!matchValue(f.params.Parent, parent) ||
+		!matchAny(f.params.Component, components) {
+		return false, nil
+	}
+
+	if !matchInNotIn(f.params.Labels, fields.Labels) ||
+		!matchInNotIn(f.params.Status, []string{fields.Status.Name}) {
+		return false, nil
+	}
+
+	ok, err := f.matchDates(fields.Created, f.params.Created, f.params.CreatedAfter, f.params.CreatedBefore)
+	if err != nil || !ok {
+		return false, err
+	}
+	ok, err = f.matchDates(fields.Updated, f.params.Updated, f.params.UpdatedAfter, f.params.UpdatedBefore)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return f.matchText(iss), nil
+}
+
+// matchValue matches the value the same way as the jql filter does, ie:
+// "x" matches empty values, "~x" matches non-empty values and a value
+// prefixed with "~" matches non-empty values except the value.
+func matchValue(filter, value string) bool {
+	if filter == "" {
+		return true
+	}
+	if filter == valueEmpty {
+		return value == ""
+	}
+	if strings.HasPrefix(filter, "~") {
+		filter = strings.TrimLeft(filter[1:], " ")
+		if filter == valueEmpty {
+			return value != ""
+		}
+		return value != "" && !strings.EqualFold(filter, value)
+	}
+	return strings.EqualFold(filter, value)
+}
+
+func matchAny(filter string, values []string) bool {
+	if filter == "" {
+		return true
+	}
+	if len(values) == 0 {
+		return matchValue(filter, "")
+	}
+	negate := strings.HasPrefix(filter, "~")
+	for _, v := range values {
+		ok := matchValue(filter, v)
+		if negate && !ok {
+			return false
+		}
+		if !negate && ok {
+			return true
+		}
+	}
+	return negate
+}
+
+// matchInNotIn matches if the values contain any of the positive filters
+// and none of the negative filters, ie: the ones prefixed with "~".
+func matchInNotIn(filters, values []string) bool {
+	var (
+		positive []string
+		has      = func(f string) bool {
+			for _, v := range values {
+				if strings.EqualFold(f, v) {
+					return true
+				}
+			}
+			return false
+		}
+	)
+
+	for _, f := range filters {
+		if strings.HasPrefix(f, "~") {
+			if has(f[1:]) {
+				return false
+			}
+			continue
+		}
+		positive = append(positive, f)
+	}
+	if len(positive) == 0 {
+		return true
+	}
+	for _, f := range positive {
+		if has(f) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *IssueFilter) matchDates(value, on, after, before string) (bool, error) {
+	if on == "" && after == "" && before == "" {
+		return true, nil
+	}
+
+	dt, err := time.Parse(jira.RFC3339, value)
+	if err != nil {
+		return false, nil
+	}
+
+	if on != "" {
+		from, to, err := f.period(on)
+		if err != nil {
+			return false, err
+		}
+		return !dt.Before(from) && (to.IsZero() || dt.Before(to)), nil
+	}
+	if after != "" {
+		from, _, err := f.period(after)
+		if err != nil {
+			return false, err
+		}
+		if !dt.After(from) {
+			return false, nil
+		}
+	}
+	if before != "" {
+		to, _, err := f.period(before)
+		if err != nil {
+			return false, err
+		}
+		if !dt.Before(to) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// period returns the start of the period and, for a date, the start of the next day.
+func (f *IssueFilter) period(value string) (time.Time, time.Time, error) {
+	now := f.now
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch value {
+	case "today":
+		return startOfDay, time.Time{}, nil
+	case "week":
+		return startOfDay.AddDate(0, 0, -int(now.Weekday())), time.Time{}, nil
+	case "month":
+		return startOfDay.AddDate(0, 0, 1-now.Day()), time.Time{}, nil
+	case "year":
+		return time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location()), time.Time{}, nil
+	}
+
+	if m := relativePeriod.FindStringSubmatch(value); m != nil {
+		n, _ := strconv.Atoi(m[2])
+		d := map[string]time.Duration{
+			"w": 7 * 24 * time.Hour,
+			"d": 24 * time.Hour,
+			"h": time.Hour,
+			"m": time.Minute,
+		}[m[3]] * time.Duration(n)
+		if m[1] == "-" {
+			d = -d
+		}
+		return now.Add(d), time.Time{}, nil
+	}
+
+	for _, format := range []string{"2006-01-02", "2006/01/02", "2006-01-02 15:04", "2006/01/02 15:04"} {
+		if dt, err := time.ParseInLocation(format, value, now.Location()); err == nil {
+			return dt, dt.AddDate(0, 0, 1), nil
+		}
+	}
+
+	return time.Time{}, time.Time{}, fmt.Errorf("invalid date or period %q", value)
+}
+
+func (f *IssueFilter) matchText(iss *jira.Issue) bool {
+	if f.text == "" {
+		return true
+	}
+
+	texts := []string{iss.Key, iss.Fields.Summary, toText(iss.Fields.Description)}
+	for _, c := range iss.Fields.Comment.Comments {
+		texts = append(texts, toText(c.Body))
+	}
+	for _, t := range texts {
+		if strings.Contains(strings.ToLower(t), f.text) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *IssueFilter) order(issues []*jira.Issue) error {
+	field := f.params.OrderBy
+	if field == "created" &&
+		(f.params.Updated != "" || f.params.UpdatedBefore != "" || f.params.UpdatedAfter != "") &&
+		(f.params.Created == "" && f.params.CreatedBefore == "" && f.params.CreatedAfter == "") {
+		field = "updated"
+	}
+
+	var compare func(a, b *jira.Issue) int
+
+	switch strings.ToLower(field) {
+	case "", "created":
+		compare = func(a, b *jira.Issue) int { return compareDates(a.Fields.Created, b.Fields.Created) }
+	case "updated":
+		compare = func(a, b *jira.Issue) int { return compareDates(a.Fields.Updated, b.Fields.Updated) }
+	case "key":
+		compare = func(a, b *jira.Issue) int { return compareKeys(a.Key, b.Key) }
+	case "summary":
+		compare = func(a, b *jira.Issue) int { return compareStrings(a.Fields.Summary, b.Fields.Summary) }
+	case "status":
+		compare = func(a, b *jira.Issue) int { return compareStrings(a.Fields.Status.Name, b.Fields.Status.Name) }
+	case "priority":
+		compare = func(a, b *jira.Issue) int { return compareStrings(a.Fields.Priority.Name, b.Fields.Priority.Name) }
+	case "assignee":
+		compare = func(a, b *jira.Issue) int { return compareStrings(a.Fields.Assignee.Name, b.Fields.Assignee.Name) }
+	case "reporter":
+		compare = func(a, b *jira.Issue) int { return compareStrings(a.Fields.Reporter.Name, b.Fields.Reporter.Name) }
+	case "type", "issuetype":
+		compare = func(a, b *jira.Issue) int { return compareStrings(a.Fields.IssueType.Name, b.Fields.IssueType.Name) }
+	default:
+		return fmt.Errorf("ordering by %q is not supported in the offline mode", field)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if f.params.Reverse {
+			return compare(issues[i], issues[j]) < 0
+		}
+		return compare(issues[j], issues[i]) < 0
+	})
+	return nil
+}
+
+func compareDates(a, b string) int {
+	x, errA := time.Parse(jira.RFC3339, a)
+	y, errB := time.Parse(jira.RFC3339, b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	return x.Compare(y)
+}
+
+func compareStrings(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// compareKeys compares the issue keys by the project key and then by the issue number.
+func compareKeys(a, b string) int {
+	pa, na, _ := strings.Cut(a, "-")
+	pb, nb, _ := strings.Cut(b, "-")
+	if c := strings.Compare(pa, pb); c != 0 {
+		return c
+	}
+	x, errA := strconv.Atoi(na)
+	y, errB := strconv.Atoi(nb)
+	if errA != nil || errB != nil {
+		return strings.Compare(na, nb)
+	}
+	return x - y
+}
+
+func toText(v any) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case *adf.ADF:
+		return adf.NewTranslator(val, adf.NewMarkdownTranslator()).Translate()
+	}
+	return ""
+}
@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestIssueFilterApply(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 1, 14, 10, 30, 0, 0, time.UTC) // Thursday
+
+	cases := []struct {
+		name     string
+		params   query.IssueParams
+		text     string
+		expected []string
+	}{
+		{
+			name:     "it orders by created date in descending order by default",
+			params:   query.IssueParams{OrderBy: "created"},
+			expected: []string{"TEST-3", "TEST-2", "TEST-10", "TEST-1"},
+		},
+		{
+			name:     "it orders in ascending order when reversed",
+			params:   query.IssueParams{OrderBy: "created", Reverse: true},
+			expected: []string{"TEST-1", "TEST-10", "TEST-2", "TEST-3"},
+		},
+		{
+			name:     "it orders by key",
+			params:   query.IssueParams{OrderBy: "key", Reverse: true},
+			expected: []string{"TEST-1", "TEST-2", "TEST-3", "TEST-10"},
+		},
+		{
+			name:     "it filters by type and status",
+			params:   query.IssueParams{OrderBy: "created", IssueType: "bug", Status: []string{"To Do", "In Progress"}},
+			expected: []string{"TEST-2"},
+		},
+		{
+			name:     "it filters by negative status",
+			params:   query.IssueParams{OrderBy: "created", Status: []string{"~Done", "~To Do"}},
+			expected: []string{"TEST-2"},
+		},
+		{
+			name:     "it filters unassigned issues",
+			params:   query.IssueParams{OrderBy: "created", Assignee: "x"},
+			expected: []string{"TEST-3", "TEST-1"},
+		},
+		{
+			name:     "it filters assigned issues except the given assignee",
+			params:   query.IssueParams{OrderBy: "created", Assignee: "~person a"},
+			expected: []string{"TEST-2"},
+		},
+		{
+			name:     "it filters by labels",
+			params:   query.IssueParams{OrderBy: "created", Labels: []string{"backend", "~urgent"}},
+			expected: []string{"TEST-10"},
+		},
+		{
+			name:     "it filters by component and parent",
+			params:   query.IssueParams{OrderBy: "created", Component: "API", Parent: "TEST-1"},
+			expected: []string{"TEST-2"},
+		},
+		{
+			name:     "it filters issues created in a date",
+			params:   query.IssueParams{OrderBy: "created", Created: "2021-01-12"},
+			expected: []string{"TEST-10"},
+		},
+		{
+			name:     "it filters issues created this week",
+			params:   query.IssueParams{OrderBy: "created", Created: "week"},
+			expected: []string{"TEST-3", "TEST-2", "TEST-10"},
+		},
+		{
+			name:     "it filters issues updated in a period and orders them by updated date",
+			params:   query.IssueParams{OrderBy: "created", Updated: "-1d"},
+			expected: []string{"TEST-3", "TEST-1"},
+		},
+		{
+			name:     "it filters issues created before and after a date",
+			params:   query.IssueParams{OrderBy: "created", CreatedAfter: "2021-01-01", CreatedBefore: "2021-01-13"},
+			expected: []string{"TEST-10"},
+		},
+		{
+			name:     "it searches the text in summary and description",
+			params:   query.IssueParams{OrderBy: "created"},
+			text:     "LOGIN",
+			expected: []string{"TEST-2", "TEST-1"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := NewIssueFilter(&tc.params, tc.text)
+			f.now = now
+
+			issues, err := f.Apply(getIssues())
+			assert.NoError(t, err)
+
+			keys := make([]string, 0, len(issues))
+			for _, iss := range issues {
+				keys = append(keys, iss.Key)
+			}
+			assert.Equal(t, tc.expected, keys)
+		})
+	}
+}
+
+func TestIssueFilterApplyUnsupported(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewIssueFilter(&query.IssueParams{JQL: "sprint IN openSprints()"}, "").Apply(getIssues())
+	assert.EqualError(t, err, "the --jql filter is not supported in the offline mode")
+
+	_, err = NewIssueFilter(&query.IssueParams{OrderBy: "rank"}, "").Apply(getIssues())
+	assert.EqualError(t, err, `ordering by "rank" is not supported in the offline mode`)
+
+	_, err = NewIssueFilter(&query.IssueParams{OrderBy: "created", Created: "yesterday"}, "").Apply(getIssues())
+	assert.EqualError(t, err, `invalid date or period "yesterday"`)
+}
+
+func TestCompareKeys(t *testing.T) {
+	t.Parallel()
+
+	assert.Negative(t, compareKeys("TEST-2", "TEST-10"))
+	assert.Positive(t, compareKeys("TEST-10", "TEST-9"))
+	assert.Negative(t, compareKeys("ABC-10", "TEST-1"))
+	assert.Zero(t, compareKeys("TEST-1", "TEST-1"))
+}
+
+func getIssues() []*jira.Issue {
+	issues := []*jira.Issue{
+		{Key: "TEST-1"},
+		{Key: "TEST-2"},
+		{Key: "TEST-3"},
+		{Key: "TEST-10"},
+	}
+
+	issues[0].Fields.Summary = "Fix login"
+	issues[0].Fields.IssueType.Name = "Story"
+	issues[0].Fields.Status.Name = "Done"
+	issues[0].Fields.Labels = []string{"backend", "urgent"}
+	issues[0].Fields.Created = "2020-12-13T14:05:20.974+0000"
+	issues[0].Fields.Updated = "2021-01-14T08:00:00.000+0000"
+
+	issues[1].Fields.Summary = "Password reset"
+	issues[1].Fields.Description = "The login page has no link"
+	issues[1].Fields.IssueType.Name = "Bug"
+	issues[1].Fields.Status.Name = "In Progress"
+	issues[1].Fields.Assignee.Name = "Person B"
+	issues[1].Fields.Parent = &struct {
+		Key string `json:"key"`
+	}{Key: "TEST-1"}
+	issues[1].Fields.Components = []struct {
+		Name string `json:"name"`
+	}{{Name: "Web"}, {Name: "API"}}
+	issues[1].Fields.Created = "2021-01-13T09:00:00.000+0000"
+	issues[1].Fields.Updated = "2021-01-13T09:00:00.000+0000"
+
+	issues[2].Fields.Summary = "Dark mode"
+	issues[2].Fields.IssueType.Name = "Story"
+	issues[2].Fields.Status.Name = "To Do"
+	issues[2].Fields.Labels = []string{"frontend"}
+	issues[2].Fields.Created = "2021-01-14T09:00:00.000+0000"
+	issues[2].Fields.Updated = "2021-01-14T09:00:00.000+0000"
+
+	issues[3].Fields.Summary = "Rate limit"
+	issues[3].Fields.IssueType.Name = "Task"
+	issues[3].Fields.Status.Name = "Done"
+	issues[3].Fields.Assignee.Name = "Person A"
+	issues[3].Fields.Labels = []string{"backend"}
+	issues[3].Fields.Created = "2021-01-12T15:00:00.000+0000"
+	issues[3].Fields.Updated = "2021-01-12T15:00:00.000+0000"
+
+	return issues
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const httpDir = "http"
+
+// HTTPCachePath returns the path to the http response cache.
+func HTTPCachePath() (string, error) {
+	dir, err := Path()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, httpDir), nil
+}
+
+// HTTPCache is an on-disk cache of the http responses. Each
+// response is stored in a separate file named after its key.
+type HTTPCache struct {
+	dir string
+}
+
+// NewHTTPCache constructs a new http cache in the given directory.
+func NewHTTPCache(dir string) *HTTPCache {
+	return &HTTPCache{dir: dir}
+}
+
+// Get returns the cached response for the key, if any.
+func (c *HTTPCache) Get(key string) (*jira.CachedResponse, bool) {
+	data, err := os.ReadFile(c.file(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var res jira.CachedResponse
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, false
+	}
+	return &res, true
+}
+
+// Set stores the response for the key.
+func (c *HTTPCache) Set(key string, res *jira.CachedResponse) error {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that concurrent readers never see a partial response.
+	tmp, err := os.CreateTemp(c.dir, "*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.file(key))
+}
+
+// Delete removes the cached response for the key.
+func (c *HTTPCache) Delete(key string) error {
+	err := os.Remove(c.file(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Clear removes all cached responses.
+func (c *HTTPCache) Clear() error {
+	return os.RemoveAll(c.dir)
+}
+
+func (c *HTTPCache) file(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
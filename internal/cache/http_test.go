@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestHTTPCachePath(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/config")
+
+	path, err := HTTPCachePath()
+	assert.NoError(t, err)
+	assert.Equal(t, "/tmp/config/.jira/cache/http", path)
+}
+
+func TestHTTPCache(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "http")
+	c := NewHTTPCache(dir)
+
+	key := "user https://example.atlassian.net/rest/api/2/field"
+
+	_, ok := c.Get(key)
+	assert.False(t, ok)
+
+	storedAt := time.Date(2021, 1, 14, 10, 30, 0, 0, time.UTC)
+	assert.NoError(t, c.Set(key, &jira.CachedResponse{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Etag": []string{`"v1"`}},
+		Body:       []byte(`[{"id": "summary"}]`),
+		StoredAt:   storedAt,
+	}))
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	res, ok := c.Get(key)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, `"v1"`, res.Header.Get("ETag"))
+	assert.Equal(t, `[{"id": "summary"}]`, string(res.Body))
+	assert.True(t, storedAt.Equal(res.StoredAt))
+
+	assert.NoError(t, c.Delete(key))
+	assert.NoError(t, c.Delete(key))
+	_, ok = c.Get(key)
+	assert.False(t, ok)
+
+	assert.NoError(t, c.Set(key, &jira.CachedResponse{StatusCode: http.StatusOK}))
+	assert.NoError(t, c.Clear())
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
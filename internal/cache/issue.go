@@ -0,0 +1,190 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	// Dir is the name of the cache directory inside the jira-cli config directory.
+	Dir = "cache"
+
+	issuesDir = "issues"
+)
+
+var unsafePathChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// Path returns the path to the cache directory.
+func Path() (string, error) {
+	home, err := cmdutil.GetConfigHome()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, jiraConfig.Dir, Dir), nil
+}
+
+// IssueStorePath returns the path to the issue store of a project in the given server.
+func IssueStorePath(server, project string) (string, error) {
+	dir, err := Path()
+	if err != nil {
+		return "", err
+	}
+
+	host := server
+	if u, err := url.Parse(server); err == nil && u.Host != "" {
+		host = u.Host + u.Path
+	}
+	host = strings.Trim(unsafePathChars.ReplaceAllString(host, "_"), "_")
+
+	return filepath.Join(dir, issuesDir, host, strings.ToUpper(project)+".json"), nil
+}
+
+// IssueStore is an on-disk store of the issues of a project.
+type IssueStore struct {
+	SyncedAt time.Time
+
+	path   string
+	issues map[string]*jira.Issue
+}
+
+type issueStoreFile struct {
+	SyncedAt time.Time     `json:"syncedAt"`
+	Issues   []*jira.Issue `json:"issues"`
+}
+
+// OpenIssueStore opens the issue store in the given path. An empty store
+// is returned if the store doesn't exist yet.
+func OpenIssueStore(path string) (*IssueStore, error) {
+	s := IssueStore{
+		path:   path,
+		issues: make(map[string]*jira.Issue),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &s, nil
+		}
+		return nil, err
+	}
+
+	var f issueStoreFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("invalid issue cache %s: %w", path, err)
+	}
+
+	s.SyncedAt = f.SyncedAt
+	for _, iss := range f.Issues {
+		normalize(iss)
+		s.issues[iss.Key] = iss
+	}
+
+	return &s, nil
+}
+
+// Synced tells if the store was synced at least once.
+func (s *IssueStore) Synced() bool {
+	return !s.SyncedAt.IsZero()
+}
+
+// Len returns the number of issues in the store.
+func (s *IssueStore) Len() int {
+	return len(s.issues)
+}
+
+// Get returns the issue with the given key.
+func (s *IssueStore) Get(key string) (*jira.Issue, bool) {
+	iss, ok := s.issues[strings.ToUpper(key)]
+	return iss, ok
+}
+
+// Issues returns all issues in the store ordered by the key.
+func (s *IssueStore) Issues() []*jira.Issue {
+	issues := make([]*jira.Issue, 0, len(s.issues))
+	for _, iss := range s.issues {
+		issues = append(issues, iss)
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		return compareKeys(issues[i].Key, issues[j].Key) < 0
+	})
+	return issues
+}
+
+// Put adds the issues to the store replacing the existing ones.
+func (s *IssueStore) Put(issues ...*jira.Issue) {
+	for _, iss := range issues {
+		normalize(iss)
+		s.issues[iss.Key] = iss
+	}
+}
+
+// Reset removes all issues from the store.
+func (s *IssueStore) Reset() {
+	s.SyncedAt = time.Time{}
+	s.issues = make(map[string]*jira.Issue)
+}
+
+// Save writes the store to the disk and marks it as synced at the given time.
+func (s *IssueStore) Save(syncedAt time.Time) error {
+	s.SyncedAt = syncedAt
+
+	data, err := json.Marshal(issueStoreFile{
+		SyncedAt: s.SyncedAt,
+		Issues:   s.Issues(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that an interrupted sync doesn't corrupt the store.
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// normalize converts the description and the comment bodies received
+// from the v3 API to ADF so that they can be rendered as usual.
+func normalize(iss *jira.Issue) {
+	iss.Fields.Description = toADF(iss.Fields.Description)
+	for i := range iss.Fields.Comment.Comments {
+		iss.Fields.Comment.Comments[i].Body = toADF(iss.Fields.Comment.Comments[i].Body)
+	}
+
+	// Search results may not contain all comments of the issue.
+	iss.Fields.Comment.Total = min(iss.Fields.Comment.Total, len(iss.Fields.Comment.Comments))
+}
+
+func toADF(v any) any {
+	if _, ok := v.(map[string]any); !ok {
+		return v
+	}
+
+	js, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var doc *adf.ADF
+	if err := json.Unmarshal(js, &doc); err != nil {
+		return v
+	}
+	return doc
+}
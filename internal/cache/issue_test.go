@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestIssueStorePath(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/config")
+
+	path, err := IssueStorePath("https://example.atlassian.net/", "test")
+	assert.NoError(t, err)
+	assert.Equal(t, "/tmp/config/.jira/cache/issues/example.atlassian.net/TEST.json", path)
+
+	path, err = IssueStorePath("http://jira.local:8080/jira", "TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, "/tmp/config/.jira/cache/issues/jira.local_8080_jira/TEST.json", path)
+}
+
+func TestIssueStore(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "issues", "TEST.json")
+
+	store, err := OpenIssueStore(path)
+	assert.NoError(t, err)
+	assert.False(t, store.Synced())
+	assert.Equal(t, 0, store.Len())
+
+	var iss jira.Issue
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"key": "TEST-10",
+		"fields": {
+			"summary": "Issue with ADF description",
+			"description": {"version": 1, "type": "doc", "content": [{"type": "paragraph", "content": [{"type": "text", "text": "Hello"}]}]},
+			"comment": {"comments": [{"id": "1", "body": "Plain comment"}], "total": 5},
+			"customfield_10016": 5
+		}
+	}`), &iss))
+
+	store.Put(&iss, &jira.Issue{Key: "TEST-2"})
+
+	syncedAt := time.Date(2021, 1, 14, 10, 30, 0, 0, time.UTC)
+	assert.NoError(t, store.Save(syncedAt))
+
+	_, err = os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+
+	store, err = OpenIssueStore(path)
+	assert.NoError(t, err)
+	assert.True(t, store.Synced())
+	assert.True(t, syncedAt.Equal(store.SyncedAt))
+	assert.Equal(t, 2, store.Len())
+
+	issues := store.Issues()
+	assert.Equal(t, "TEST-2", issues[0].Key)
+	assert.Equal(t, "TEST-10", issues[1].Key)
+
+	got, ok := store.Get("test-10")
+	assert.True(t, ok)
+	assert.Equal(t, "Issue with ADF description", got.Fields.Summary)
+	assert.IsType(t, &adf.ADF{}, got.Fields.Description)
+	assert.Equal(t, "Plain comment", got.Fields.Comment.Comments[0].Body)
+	assert.Equal(t, 1, got.Fields.Comment.Total)
+	assert.Equal(t, json.RawMessage(`5`), got.Fields.CustomFields["customfield_10016"])
+
+	_, ok = store.Get("TEST-1")
+	assert.False(t, ok)
+
+	store.Reset()
+	assert.False(t, store.Synced())
+	assert.Equal(t, 0, store.Len())
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/board/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/board/view"
 )
 
 const helpText = `Board manages Jira boards in a project. See available commands below.`
@@ -19,7 +20,7 @@ func NewCmdBoard() *cobra.Command {
 		RunE:        board,
 	}
 
-	cmd.AddCommand(list.NewCmdList())
+	cmd.AddCommand(list.NewCmdList(), view.NewCmdView())
 
 	return &cmd
 }
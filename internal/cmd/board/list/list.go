@@ -2,25 +2,40 @@ package list
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
+const examples = `$ jira board list
+
+# Format each item using a Go template
+$ jira board list --template '{{.ID}} {{.Name}} ({{.Type}})'
+
+# Print selected fields as JSON
+$ jira board list --json id,name,type`
+
 // NewCmdList is a list command.
 func NewCmdList() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "list",
 		Short:   "List lists boards in a project",
 		Long:    "List lists boards in a project.",
+		Example: examples,
 		Aliases: []string{"lists", "ls"},
 		Run:     List,
 	}
+
+	cmdcommon.SetOutputFlags(&cmd)
+
+	return &cmd
 }
 
 // List displays a list view.
@@ -53,6 +68,11 @@ func List(cmd *cobra.Command, _ []string) {
 		return
 	}
 
+	if output := cmdcommon.GetOutput(cmd.Flags()); output.Enabled() {
+		cmdutil.ExitIfError(view.RenderOutput(os.Stdout, output, boards))
+		return
+	}
+
 	v := view.NewBoard(boards)
 
 	cmdutil.ExitIfError(v.Render())
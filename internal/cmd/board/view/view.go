@@ -0,0 +1,169 @@
+package view
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	tuiView "github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `View displays the issues in a board as a kanban board.
+
+The columns and the statuses mapped to them are read from the board configuration.
+Issues with a status that is not mapped to any column are not shown. The board
+configured during init is used if the board ID is not passed.`
+
+	examples = `$ jira board view
+
+# View a specific board
+$ jira board view 42
+
+# Only show issues assigned to you
+$ jira board view --jql "assignee = currentUser()"`
+
+	// boardIssuesPageSize is the max number of issues fetched at once from the board.
+	boardIssuesPageSize = 50
+)
+
+// NewCmdView is a view command.
+func NewCmdView() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "view [BOARD_ID]",
+		Short:   "View issues in a board as a kanban board",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"show", "kanban"},
+		Annotations: map[string]string{
+			"help:args": "[BOARD_ID]\tID of the board, defaults to the board configured during init",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  view,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringP("jql", "q", "", "Filter issues in the board using JQL")
+	cmd.Flags().Uint("limit", 200, "Max number of issues to show in the board")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+
+	return &cmd
+}
+
+func view(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	if params.boardID == 0 {
+		cmdutil.Failed("Board ID is required, either pass it as an argument or configure a board during init")
+	}
+
+	board, statuses, issues, err := func() (*jira.BoardConfiguration, []*jira.Status, []*jira.Issue, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching issues in board #%d...", params.boardID))
+		defer s.Stop()
+
+		board, err := client.GetBoardConfiguration(params.boardID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		statuses, err := client.Statuses()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		issues, err := boardIssues(client, params.boardID, params.jql, params.limit)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return board, statuses, issues, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	v := tuiView.KanbanBoard{
+		Server:   viper.GetString("server"),
+		Board:    board,
+		Statuses: statuses,
+		Data:     issues,
+		Refresh: func() {
+			view(cmd, args)
+		},
+		Display: tuiView.DisplayFormat{
+			Plain:      params.plain,
+			NoHeaders:  params.noHeaders,
+			TableStyle: cmdutil.GetTUIStyleConfig(),
+		},
+	}
+
+	cmdutil.ExitIfError(v.Render())
+}
+
+// boardIssues fetches issues in the board page by page until the limit is reached.
+func boardIssues(client *jira.Client, boardID int, jql string, limit uint) ([]*jira.Issue, error) {
+	var issues []*jira.Issue
+
+	for from := uint(0); from < limit; {
+		res, err := client.BoardIssues(boardID, jql, from, min(boardIssuesPageSize, limit-from))
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, res.Issues...)
+
+		from += uint(len(res.Issues))
+		if len(res.Issues) == 0 || from >= uint(res.Total) {
+			break
+		}
+	}
+
+	return issues, nil
+}
+
+type viewParams struct {
+	boardID   int
+	jql       string
+	limit     uint
+	plain     bool
+	noHeaders bool
+	debug     bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *viewParams {
+	boardID := viper.GetInt("board.id")
+	if len(args) > 0 {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			cmdutil.Failed("Invalid board ID %q", args[0])
+		}
+		boardID = id
+	}
+
+	jql, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	limit, err := flags.GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	plain, err := flags.GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := flags.GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &viewParams{
+		boardID:   boardID,
+		jql:       jql,
+		limit:     limit,
+		plain:     plain,
+		noHeaders: noHeaders,
+		debug:     debug,
+	}
+}
@@ -0,0 +1,27 @@
+package cache
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/cache/clear"
+)
+
+const helpText = `Cache manages the local cache of the tool. See available commands below.`
+
+// NewCmdCache is a cache command.
+func NewCmdCache() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "cache",
+		Short: "Cache manages the local cache",
+		Long:  helpText,
+		RunE:  cache,
+	}
+
+	cmd.AddCommand(clear.NewCmdClear())
+
+	return &cmd
+}
+
+func cache(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,57 @@
+package clear
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cache"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Clear removes the cached responses of the server.
+
+The issues synced for the offline mode are kept unless the --all flag is used.`
+
+	examples = `$ jira cache clear
+
+# Remove the synced issues as well
+$ jira cache clear --all`
+)
+
+// NewCmdClear is a clear command.
+func NewCmdClear() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "clear",
+		Short:   "Clear removes the cached responses",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     clearCache,
+	}
+
+	cmd.Flags().Bool("all", false, "Remove the issues synced using 'jira sync' as well")
+
+	return &cmd
+}
+
+func clearCache(cmd *cobra.Command, _ []string) {
+	all, err := cmd.Flags().GetBool("all")
+	cmdutil.ExitIfError(err)
+
+	if all {
+		dir, err := cache.Path()
+		cmdutil.ExitIfError(err)
+		cmdutil.ExitIfError(os.RemoveAll(dir))
+
+		cmdutil.Success("Cleared the cached responses and the synced issues")
+		return
+	}
+
+	dir, err := cache.HTTPCachePath()
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cache.NewHTTPCache(dir).Clear())
+
+	cmdutil.Success("Cleared the cached responses")
+}
@@ -0,0 +1,241 @@
+package add
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Add adds a named context to the config.
+
+The settings of the context are merged over the top-level settings in the config when it
+is active. The API token for the context is resolved from the JIRA_API_TOKEN env variable,
+.netrc file or the keyring using the server and login of the context.
+
+Use --from-file to import a complete config generated using 'jira init --config FILE'
+for another installation, which includes the metadata like issue types and custom fields.`
+
+	examples = `# Add a context using an interactive prompt
+$ jira context add onprem
+
+# Pass the details directly and switch to the context
+$ jira context add onprem --installation local --server https://jira.example.com --login jon --auth-type bearer --project PRJ --use
+
+# Import a config generated using 'jira init --config ./onprem.yml'
+$ jira context add onprem --from-file ./onprem.yml`
+)
+
+type addParams struct {
+	name         string
+	installation string
+	server       string
+	login        string
+	authType     string
+	project      string
+	fromFile     string
+	use          bool
+	noInput      bool
+}
+
+// NewCmdAdd is an add command.
+func NewCmdAdd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "add NAME",
+		Short:   "Add adds a named context",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"create"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the context, eg: cloud, onprem",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  add,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("installation", "", "Is this a 'cloud' or 'local' jira installation?")
+	cmd.Flags().String("server", "", "Link to your jira server")
+	cmd.Flags().String("login", "", "Jira login username or email based on your setup")
+	cmd.Flags().String("auth-type", "", "Authentication type can be basic, bearer or mtls")
+	cmd.Flags().String("project", "", "Your default project key in the context")
+	cmd.Flags().String("from-file", "", "Import settings from a config file generated using 'jira init'")
+	cmd.Flags().Bool("use", false, "Switch to the context after adding it")
+	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+
+	return &cmd
+}
+
+func add(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args)
+
+	var (
+		settings map[string]interface{}
+		err      error
+	)
+
+	if params.fromFile != "" {
+		settings, err = jiraConfig.ReadContextSettings(params.fromFile)
+	} else {
+		settings, err = contextSettings(params)
+	}
+	cmdutil.ExitIfError(err)
+
+	file := viper.ConfigFileUsed()
+
+	cmdutil.ExitIfError(jiraConfig.AddContext(file, params.name, settings))
+	if params.use {
+		cmdutil.ExitIfError(jiraConfig.UseContext(file, params.name))
+	}
+
+	cmdutil.Success("Context %q added to %s", strings.ToLower(params.name), file)
+}
+
+func contextSettings(params *addParams) (map[string]interface{}, error) {
+	if !params.noInput {
+		if err := askMissing(params); err != nil {
+			return nil, err
+		}
+	}
+	if params.server == "" || params.login == "" {
+		return nil, fmt.Errorf("server and login are required")
+	}
+
+	settings := map[string]interface{}{
+		"installation": jira.InstallationTypeCloud,
+		"server":       strings.TrimSuffix(params.server, "/"),
+		"login":        params.login,
+	}
+	if strings.EqualFold(params.installation, jira.InstallationTypeLocal) {
+		settings["installation"] = jira.InstallationTypeLocal
+	}
+	if params.authType != "" {
+		settings["auth_type"] = strings.ToLower(params.authType)
+	}
+	if params.project != "" {
+		settings["project"] = map[string]interface{}{"key": params.project}
+	}
+
+	return settings, nil
+}
+
+func askMissing(params *addParams) error {
+	var qs []*survey.Question
+
+	if params.installation == "" {
+		qs = append(qs, &survey.Question{
+			Name: "installation",
+			Prompt: &survey.Select{
+				Message: "Installation type:",
+				Help:    "Is this a cloud installation or an on-premise (local) installation.",
+				Options: []string{jira.InstallationTypeCloud, jira.InstallationTypeLocal},
+				Default: jira.InstallationTypeCloud,
+			},
+		})
+	}
+	if params.server == "" {
+		qs = append(qs, &survey.Question{
+			Name: "server",
+			Prompt: &survey.Input{
+				Message: "Link to Jira server:",
+				Help:    "This is a link to your jira server, eg: https://company.atlassian.net",
+			},
+			Validate: survey.Required,
+		})
+	}
+	if params.login == "" {
+		qs = append(qs, &survey.Question{
+			Name: "login",
+			Prompt: &survey.Input{
+				Message: "Login email or username:",
+				Help:    "This is the email or username you use to log in to the jira server.",
+			},
+			Validate: survey.Required,
+		})
+	}
+	if params.project == "" {
+		qs = append(qs, &survey.Question{
+			Name: "project",
+			Prompt: &survey.Input{
+				Message: "Default project key:",
+				Help:    "Leave empty to use the project from the top-level config.",
+			},
+		})
+	}
+
+	if len(qs) == 0 {
+		return nil
+	}
+
+	ans := struct {
+		Installation string
+		Server       string
+		Login        string
+		Project      string
+	}{}
+	if err := survey.Ask(qs, &ans); err != nil {
+		return err
+	}
+
+	if ans.Installation != "" {
+		params.installation = ans.Installation
+	}
+	if ans.Server != "" {
+		params.server = ans.Server
+	}
+	if ans.Login != "" {
+		params.login = ans.Login
+	}
+	if ans.Project != "" {
+		params.project = ans.Project
+	}
+
+	return nil
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *addParams {
+	installation, err := flags.GetString("installation")
+	cmdutil.ExitIfError(err)
+
+	server, err := flags.GetString("server")
+	cmdutil.ExitIfError(err)
+
+	login, err := flags.GetString("login")
+	cmdutil.ExitIfError(err)
+
+	authType, err := flags.GetString("auth-type")
+	cmdutil.ExitIfError(err)
+
+	project, err := flags.GetString("project")
+	cmdutil.ExitIfError(err)
+
+	fromFile, err := flags.GetString("from-file")
+	cmdutil.ExitIfError(err)
+
+	use, err := flags.GetBool("use")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	return &addParams{
+		name:         args[0],
+		installation: installation,
+		server:       server,
+		login:        login,
+		authType:     authType,
+		project:      project,
+		fromFile:     fromFile,
+		use:          use,
+		noInput:      noInput,
+	}
+}
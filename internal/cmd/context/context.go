@@ -0,0 +1,39 @@
+package context
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/context/add"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/context/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/context/use"
+)
+
+const helpText = `Context manages named contexts in the config. A context is a named set of settings,
+eg: server, login and project, that is merged over the top-level settings when active.
+
+The active context is resolved from the --context flag, JIRA_CONTEXT env variable or
+the 'context' key in the config, in that order.`
+
+// NewCmdContext is a context command.
+func NewCmdContext() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "context",
+		Short:       "Context manages named config contexts",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Aliases:     []string{"contexts", "ctx"},
+		RunE:        contexts,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		use.NewCmdUse(),
+		add.NewCmdAdd(),
+	)
+
+	return &cmd
+}
+
+func contexts(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,31 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List lists contexts defined in the config",
+		Long:    "List lists contexts defined in the config. The active context is marked with an asterisk.",
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+}
+
+// List displays a list view.
+func List(*cobra.Command, []string) {
+	contexts := jiraConfig.ListContexts()
+	if len(contexts) == 0 {
+		cmdutil.Failed("No contexts found. Run 'jira context add' to add one.")
+		return
+	}
+
+	cmdutil.ExitIfError(view.NewContextList(contexts).Render())
+}
@@ -0,0 +1,42 @@
+package use
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	helpText = `Use sets the active context in the config.
+
+The active context can also be overridden per command using the
+--context flag or the JIRA_CONTEXT env variable.`
+	examples = `$ jira context use onprem`
+)
+
+// NewCmdUse is a use command.
+func NewCmdUse() *cobra.Command {
+	return &cobra.Command{
+		Use:     "use NAME",
+		Short:   "Use sets the active context",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"switch"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the context, see 'jira context list'",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  use,
+	}
+}
+
+func use(_ *cobra.Command, args []string) {
+	name := args[0]
+
+	err := jiraConfig.UseContext(viper.ConfigFileUsed(), name)
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Switched to context %q", name)
+}
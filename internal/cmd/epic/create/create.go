@@ -1,6 +1,8 @@
 package create
 
 import (
+	"fmt"
+
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -9,6 +11,7 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/template"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
@@ -52,6 +55,8 @@ func create(cmd *cobra.Command, _ []string) {
 	installation := viper.GetString("installation")
 
 	params := parseFlags(cmd.Flags())
+	cmdutil.ExitIfError(checkTemplate(params.Template))
+	cmdcommon.SetCreateDefaults(params)
 	client := api.DefaultClient(params.Debug)
 	cc := createCmd{
 		client: client,
@@ -157,7 +162,7 @@ func create(cmd *cobra.Command, _ []string) {
 			cr.WithCustomFields(configuredCustomFields)
 		}
 
-		resp, err := client.CreateV2(&cr)
+		resp, err := api.ProxyCreate(client, &cr)
 		if err != nil {
 			return "", err
 		}
@@ -226,6 +231,23 @@ func (cc *createCmd) getQuestions(projectType string) []*survey.Question {
 	return qs
 }
 
+// checkTemplate makes sure that the template only has a description. The issue details
+// of the other templates, eg: type and sub-tasks, can't be applied to an epic.
+func checkTemplate(path string) error {
+	if path == "" || path == "-" {
+		return nil
+	}
+
+	tmpl, err := template.Load(path)
+	if err != nil {
+		return err
+	}
+	if !tmpl.IsPlain() {
+		return fmt.Errorf("%s is an issue template, only templates without a front matter can be used to create an epic", path)
+	}
+	return nil
+}
+
 type createCmd struct {
 	client *jira.Client
 	params *cmdcommon.CreateParams
@@ -316,7 +338,7 @@ func parseFlags(flags query.FlagParser) *cmdcommon.CreateParams {
 		FixVersions:     fixVersions,
 		AffectsVersions: affectsVersions,
 		CustomFields:    custom,
-		Template:        template,
+		Template:        cmdcommon.ResolveTemplate(template),
 		NoInput:         noInput,
 		Debug:           debug,
 	}
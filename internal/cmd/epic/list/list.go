@@ -2,6 +2,7 @@ package list
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
@@ -39,7 +41,14 @@ $ jira epic list <KEY> --plain --no-headers
 
 # Display some columns of epic or epic issues in a plain table view
 $ jira epic list --table --plain --columns key,summary,status
-$ jira epic list <KEY> --plain --columns type,key,summary`
+$ jira epic list <KEY> --plain --columns type,key,summary
+
+# Format epics or epic issues using a Go template
+$ jira epic list --template '{{.Key}}: {{.Fields.Summary}}'
+$ jira epic list <KEY> --template '{{.Key}} [{{.Fields.Status.Name}}]'
+
+# Print selected fields of epic issues as JSON
+$ jira epic list <KEY> --json key,summary,assignee.displayName`
 )
 
 // NewCmdList is a list command.
@@ -124,6 +133,11 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 		return
 	}
 
+	if output := cmdcommon.GetOutput(flags); output.Enabled() {
+		cmdutil.ExitIfError(view.RenderOutput(os.Stdout, output, issues))
+		return
+	}
+
 	plain, err := flags.GetBool("plain")
 	cmdutil.ExitIfError(err)
 
@@ -145,10 +159,13 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 	columns, err := flags.GetString("columns")
 	cmdutil.ExitIfError(err)
 
+	customFields, _ := cmdcommon.GetConfiguredCustomFields()
+
 	v := view.IssueList{
 		Project: project,
 		Server:  server,
 		Data:    issues,
+		Client:  client,
 		Refresh: func() {
 			singleEpicView(flags, key, project, projectType, server, client)
 		},
@@ -165,8 +182,9 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 				}
 				return []string{}
 			}(),
-			TableStyle: cmdutil.GetTUIStyleConfig(),
-			Timezone:   viper.GetString("timezone"),
+			TableStyle:   cmdutil.GetTUIStyleConfig(),
+			Timezone:     viper.GetString("timezone"),
+			CustomFields: customFields,
 		},
 	}
 
@@ -228,7 +246,7 @@ func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, proje
 	table, err := flags.GetBool("table")
 	cmdutil.ExitIfError(err)
 
-	if table || tui.IsDumbTerminal() || tui.IsNotTTY() {
+	if table || cmdcommon.GetOutput(flags).Enabled() || tui.IsDumbTerminal() || tui.IsNotTTY() {
 		list.List(cmd, nil)
 	} else {
 		cmdutil.ExitIfError(v.Render())
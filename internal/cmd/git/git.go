@@ -0,0 +1,27 @@
+package git
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/git/hook"
+)
+
+const helpText = `Git integrates the tool with your git repositories. See available commands below.`
+
+// NewCmdGit is a git command.
+func NewCmdGit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "git",
+		Short: "Git integrates the tool with git repositories",
+		Long:  helpText,
+		RunE:  git,
+	}
+
+	cmd.AddCommand(hook.NewCmdHook())
+
+	return &cmd
+}
+
+func git(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
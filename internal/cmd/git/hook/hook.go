@@ -0,0 +1,28 @@
+package hook
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/git/hook/install"
+)
+
+const helpText = `Hook manages the git hooks of the current repository. See available commands below.`
+
+// NewCmdHook is a hook command.
+func NewCmdHook() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "hook",
+		Short:   "Hook manages the git hooks of the current repository",
+		Long:    helpText,
+		Aliases: []string{"hooks"},
+		RunE:    hook,
+	}
+
+	cmd.AddCommand(install.NewCmdInstall())
+
+	return &cmd
+}
+
+func hook(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
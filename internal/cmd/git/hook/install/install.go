@@ -0,0 +1,109 @@
+package install
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/git"
+)
+
+const (
+	helpText = `Install installs a commit-msg hook in the current repository.
+
+The hook prefixes the commit messages with the issue key in the name of the
+current branch, eg: a commit on feature/ISSUE-1-fix-login is prefixed with
+"[ISSUE-1]". Messages that already mention the issue key, and fixup, squash
+and merge commits are left untouched.`
+
+	examples = `$ jira git hook install
+
+# Overwrite the existing commit-msg hook
+$ jira git hook install --force`
+
+	hookName = "commit-msg"
+
+	// hookMarker identifies the hooks installed by the tool.
+	hookMarker = "# jira-cli: commit-msg hook"
+
+	hookScript = `#!/bin/sh
+` + hookMarker + `
+#
+# Prefixes the commit message with the issue key in the name of the
+# current branch. Installed by 'jira git hook install'.
+
+project="{{project}}"
+msg_file="$1"
+
+branch=$(git rev-parse --abbrev-ref HEAD 2>/dev/null) || exit 0
+
+key=""
+if [ -n "$project" ]; then
+	key=$(printf '%s\n' "$branch" | grep -ioE "(^|[^A-Za-z0-9])$project-[0-9]+" | head -n 1)
+fi
+if [ -z "$key" ]; then
+	key=$(printf '%s\n' "$branch" | grep -oE '(^|[^A-Za-z0-9])[A-Z][A-Z0-9_]*-[0-9]+' | head -n 1)
+fi
+key=$(printf '%s' "$key" | sed -E 's/^[^A-Za-z]//' | tr '[:lower:]' '[:upper:]')
+[ -z "$key" ] && exit 0
+
+# Leave empty messages alone so that git can abort the commit.
+grep -v '^#' "$msg_file" | grep -q '[^[:space:]]' || exit 0
+
+# Skip fixup, squash and merge commits, and messages that already mention the key.
+head -n 1 "$msg_file" | grep -qE '^(fixup!|squash!|amend!|Merge )' && exit 0
+grep -v '^#' "$msg_file" | grep -qE "(^|[^A-Za-z0-9])$key([^0-9]|$)" && exit 0
+
+{ printf '[%s] ' "$key"; cat "$msg_file"; } > "$msg_file.jira" && mv "$msg_file.jira" "$msg_file"
+`
+)
+
+// NewCmdInstall is an install command.
+func NewCmdInstall() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "install",
+		Short:   "Install a commit-msg hook that adds the issue key to the commit messages",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     install,
+	}
+
+	cmd.Flags().Bool("force", false, "Overwrite the existing commit-msg hook")
+
+	return &cmd
+}
+
+func install(cmd *cobra.Command, _ []string) {
+	force, err := cmd.Flags().GetBool("force")
+	cmdutil.ExitIfError(err)
+
+	dir, err := git.HooksDir()
+	cmdutil.ExitIfError(err)
+
+	path := filepath.Join(dir, hookName)
+
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		cmdutil.ExitIfError(err)
+	}
+	if len(existing) > 0 && !bytes.Contains(existing, []byte(hookMarker)) && !force {
+		cmdutil.Failed("A %s hook already exists at %s, use --force to overwrite it", hookName, path)
+	}
+
+	script := strings.ReplaceAll(hookScript, "{{project}}", viper.GetString("project.key"))
+
+	cmdutil.ExitIfError(os.MkdirAll(dir, 0o755))
+	cmdutil.ExitIfError(os.WriteFile(path, []byte(script), 0o755)) //nolint:gosec
+	// WriteFile doesn't change the permissions of an existing file.
+	cmdutil.ExitIfError(os.Chmod(path, 0o755)) //nolint:gosec
+
+	cmdutil.Success("Installed the %s hook", hookName)
+	fmt.Println(path)
+}
@@ -0,0 +1,73 @@
+package add
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Add uploads one or more files as attachments to an issue.`
+	examples = `$ jira issue attachment add ISSUE-1 /path/to/file.log
+
+# Upload multiple files at once
+$ jira issue attachment add ISSUE-1 screenshot.png report.pdf`
+)
+
+// NewCmdAttachmentAdd is an attachment add command.
+func NewCmdAttachmentAdd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "add ISSUE-KEY FILE...",
+		Short:   "Add attachments to an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"upload"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"FILE\tPath to the file to upload",
+		},
+		Args: cobra.MinimumNArgs(2),
+		Run:  add,
+	}
+}
+
+func add(cmd *cobra.Command, args []string) {
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	files := args[1:]
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	for _, file := range files {
+		attachments, err := upload(client, key, file)
+		cmdutil.ExitIfError(err)
+
+		for _, a := range attachments {
+			cmdutil.Success("Attachment %q (ID: %s) added to issue %q", a.Filename, a.ID, key)
+		}
+	}
+
+	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(viper.GetString("server"), key))
+}
+
+func upload(client *jira.Client, key, file string) ([]*jira.Attachment, error) {
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	s := cmdutil.Info(fmt.Sprintf("Uploading %q", filepath.Base(file)))
+	defer s.Stop()
+
+	return client.AddIssueAttachment(key, file, f)
+}
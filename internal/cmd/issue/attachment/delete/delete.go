@@ -0,0 +1,53 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Delete removes one or more attachments.`
+	examples = `$ jira issue attachment delete 10001
+
+# Delete multiple attachments at once
+$ jira issue attachment delete 10001 10002`
+)
+
+// NewCmdAttachmentDelete is an attachment delete command.
+func NewCmdAttachmentDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete ATTACHMENT_ID...",
+		Short:   "Delete attachments",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": `ATTACHMENT_ID	ID of the attachment, see 'jira issue attachment list'`,
+		},
+		Args: cobra.MinimumNArgs(1),
+		Run:  del,
+	}
+}
+
+func del(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	for _, id := range args {
+		err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("Removing attachment %q", id))
+			defer s.Stop()
+
+			return client.DeleteAttachment(id)
+		}()
+		cmdutil.ExitIfError(err)
+
+		cmdutil.Success("Attachment %q removed successfully", id)
+	}
+}
@@ -0,0 +1,194 @@
+package download
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Download downloads attachments of an issue.
+
+All attachments of the issue are downloaded if no attachment ID is provided. Attachments
+with the same file name are saved with a numbered suffix, eg: "screenshot (1).png". Existing
+files are not overwritten unless --force is given.`
+	examples = `$ jira issue attachment download ISSUE-1
+
+# Download selected attachments only
+$ jira issue attachment download ISSUE-1 10001 10002
+
+# Download attachments to a specific directory
+$ jira issue attachment download ISSUE-1 --dir /tmp/attachments
+
+# Overwrite the files downloaded earlier
+$ jira issue attachment download ISSUE-1 --force`
+)
+
+// NewCmdAttachmentDownload is an attachment download command.
+func NewCmdAttachmentDownload() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "download ISSUE-KEY [ATTACHMENT_ID...]",
+		Short:   "Download attachments of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"get"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"ATTACHMENT_ID\tID of the attachment to download, see 'jira issue attachment list'",
+		},
+		Args: cobra.MinimumNArgs(1),
+		Run:  download,
+	}
+
+	cmd.Flags().StringP("dir", "d", ".", "Directory to save the attachments to")
+	cmd.Flags().Bool("force", false, "Overwrite the files that already exist in the directory")
+
+	return &cmd
+}
+
+func download(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd, args)
+	client := api.DefaultClient(params.debug)
+
+	attachments, err := func() ([]*jira.Attachment, error) {
+		s := cmdutil.Info("Fetching attachments...")
+		defer s.Stop()
+
+		return client.GetIssueAttachments(params.key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	attachments = filter(attachments, params.ids)
+	if len(attachments) == 0 {
+		cmdutil.Failed("No attachments found in issue %q", params.key)
+		return
+	}
+
+	targets := targetPaths(params.dir, attachments)
+	if !params.force {
+		for _, target := range targets {
+			if _, err := os.Stat(target); err == nil {
+				cmdutil.Failed("File %s already exists, use --force to overwrite", target)
+			}
+		}
+	}
+
+	cmdutil.ExitIfError(os.MkdirAll(params.dir, 0o755))
+
+	for i, a := range attachments {
+		cmdutil.ExitIfError(save(client, a, targets[i]))
+		cmdutil.Success("Downloaded %q to %s", a.Filename, targets[i])
+	}
+}
+
+type downloadParams struct {
+	key   string
+	ids   []string
+	dir   string
+	force bool
+	debug bool
+}
+
+func parseArgsAndFlags(cmd *cobra.Command, args []string) *downloadParams {
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	dir, err := cmd.Flags().GetString("dir")
+	cmdutil.ExitIfError(err)
+
+	force, err := cmd.Flags().GetBool("force")
+	cmdutil.ExitIfError(err)
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &downloadParams{
+		key:   key,
+		ids:   args[1:],
+		dir:   dir,
+		force: force,
+		debug: debug,
+	}
+}
+
+func filter(attachments []*jira.Attachment, ids []string) []*jira.Attachment {
+	if len(ids) == 0 {
+		return attachments
+	}
+
+	wanted := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		wanted[id] = struct{}{}
+	}
+
+	out := make([]*jira.Attachment, 0, len(ids))
+	for _, a := range attachments {
+		if _, ok := wanted[a.ID]; ok {
+			out = append(out, a)
+			delete(wanted, a.ID)
+		}
+	}
+	for id := range wanted {
+		cmdutil.Warn("Attachment %q not found in the issue, skipping", id)
+	}
+	return out
+}
+
+// targetPaths returns the paths to save the attachments to. Jira allows attachments with the
+// same file name, so a numbered suffix is added to the name of the duplicates to keep them all.
+func targetPaths(dir string, attachments []*jira.Attachment) []string {
+	used := make(map[string]struct{}, len(attachments))
+	out := make([]string, 0, len(attachments))
+
+	for _, a := range attachments {
+		name := filepath.Base(a.Filename)
+		ext := filepath.Ext(name)
+		base := strings.TrimSuffix(name, ext)
+
+		for n := 1; ; n++ {
+			if _, ok := used[name]; !ok {
+				break
+			}
+			name = fmt.Sprintf("%s (%d)%s", base, n, ext)
+		}
+		used[name] = struct{}{}
+
+		out = append(out, filepath.Join(dir, name))
+	}
+	return out
+}
+
+func save(client *jira.Client, a *jira.Attachment, target string) error {
+	f, err := os.Create(filepath.Clean(target))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	msg := fmt.Sprintf("Downloading %q", a.Filename)
+
+	s := cmdutil.Info(msg)
+	defer s.Stop()
+
+	_, err = client.DownloadAttachment(a, f, func(transferred, total int64) {
+		s.Lock()
+		defer s.Unlock()
+
+		if total > 0 {
+			s.Suffix = fmt.Sprintf(" %s (%d%%)", msg, transferred*100/total)
+		} else {
+			s.Suffix = fmt.Sprintf(" %s (%d bytes)", msg, transferred)
+		}
+	})
+	if err != nil {
+		_ = os.Remove(target)
+	}
+	return err
+}
@@ -0,0 +1,56 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `List lists attachments of an issue.`
+	examples = `$ jira issue attachment list ISSUE-1`
+)
+
+// NewCmdAttachmentList is an attachment list command.
+func NewCmdAttachmentList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list ISSUE-KEY",
+		Short:   "List attachments of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Annotations: map[string]string{
+			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1`,
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  list,
+	}
+}
+
+func list(cmd *cobra.Command, args []string) {
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	attachments, err := func() ([]*jira.Attachment, error) {
+		s := cmdutil.Info("Fetching attachments...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).GetIssueAttachments(key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(attachments) == 0 {
+		cmdutil.Failed("No attachments found in issue %q", key)
+		return
+	}
+
+	v := view.NewAttachment(attachments)
+
+	cmdutil.ExitIfError(v.Render())
+}
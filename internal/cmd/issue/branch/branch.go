@@ -0,0 +1,116 @@
+package branch
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/git"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Branch creates a git branch for an issue and checks it out.
+
+The branch is named using the branch.template config, which defaults to
+"feature/{{.Key}}-{{slug .Summary}}". The template has access to the Key, Summary,
+Type and Status of the issue and the slug, lower and upper functions.
+
+If a local branch for the issue already exists, it is checked out instead.`
+
+	examples = `$ jira issue branch ISSUE-1
+
+# Create the branch from the main branch
+$ jira issue branch ISSUE-1 --base main
+
+# Use a custom branch name
+$ jira issue branch ISSUE-1 --name hotfix/ISSUE-1
+
+# Configure the branch names in the config file
+branch:
+  template: "{{lower .Type}}/{{.Key}}-{{slug .Summary}}"`
+)
+
+// NewCmdBranch is a branch command.
+func NewCmdBranch() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "branch ISSUE-KEY",
+		Short:   "Create or checkout a git branch for an issue",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1`,
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  branch,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("name", "", "Use the given branch name instead of the branch template")
+	cmd.Flags().String("base", "", "Create the branch from the given branch or commit instead of HEAD")
+
+	return &cmd
+}
+
+func branch(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	key := cmdutil.GetJiraIssueKey(project, args[0])
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	name, err := cmd.Flags().GetString("name")
+	cmdutil.ExitIfError(err)
+
+	base, err := cmd.Flags().GetString("base")
+	cmdutil.ExitIfError(err)
+
+	current, err := git.CurrentBranch()
+	cmdutil.ExitIfError(err)
+
+	if name == "" {
+		name, err = existingBranch(key, project)
+		cmdutil.ExitIfError(err)
+	}
+
+	if name == "" {
+		iss, err := func() (*jira.Issue, error) {
+			s := cmdutil.Info("Fetching issue details...")
+			defer s.Stop()
+
+			return api.ProxyGetIssue(api.DefaultClient(debug), key)
+		}()
+		cmdutil.ExitIfError(err)
+
+		name, err = cmdutil.BranchName(viper.GetString("branch.template"), iss)
+		cmdutil.ExitIfError(err)
+	}
+
+	switch {
+	case name == current:
+		cmdutil.Success("Already on branch %q", name)
+	case git.BranchExists(name):
+		cmdutil.ExitIfError(git.Checkout(name))
+		cmdutil.Success("Switched to branch %q", name)
+	default:
+		cmdutil.ExitIfError(git.ValidateBranchName(name))
+		cmdutil.ExitIfError(git.CreateBranch(name, base))
+		cmdutil.Success("Switched to a new branch %q", name)
+	}
+}
+
+// existingBranch returns the local branch created for the issue, if any.
+func existingBranch(key, project string) (string, error) {
+	branches, err := git.Branches()
+	if err != nil {
+		return "", err
+	}
+	for _, b := range branches {
+		if cmdutil.IssueKeyFromBranch(b, project) == key {
+			return b, nil
+		}
+	}
+	return "", nil
+}
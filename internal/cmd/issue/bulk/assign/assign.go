@@ -0,0 +1,152 @@
+package assign
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Assign assigns all the matching issues to a user.`
+	examples = `$ jira issue bulk assign jon@domain.tld --jql "component = API AND assignee is EMPTY"
+
+# Assignee name or email needs to be an exact match
+$ jira issue bulk assign "Jon Doe" -q "sprint in openSprints() AND labels = backend"
+
+# Assign to default assignee
+$ jira issue bulk assign default -q "status = 'To Do'"
+
+# Unassign the issues passed through the standard input
+$ echo "ISSUE-1,ISSUE-2" | jira issue bulk assign x`
+)
+
+// NewCmdAssign is a bulk assign command.
+func NewCmdAssign() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "assign ASSIGNEE",
+		Short:   "Assign multiple issues to a user",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"asg"},
+		Annotations: map[string]string{
+			"help:args": "ASSIGNEE\tEmail or display name of the user to assign the issues to, 'default' or 'x' to unassign",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  assign,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmdcommon.SetBulkFlags(&cmd)
+
+	return &cmd
+}
+
+func assign(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.bulk.Debug)
+
+	var (
+		user     *jira.User
+		assignee string
+		uname    = params.user
+	)
+
+	switch strings.ToLower(params.user) {
+	case "x":
+		assignee, uname = jira.AssigneeNone, "unassigned"
+	case jira.AssigneeDefault:
+		assignee = jira.AssigneeDefault
+	default:
+		u, err := func() (*jira.User, error) {
+			s := cmdutil.Info("Fetching user details...")
+			defer s.Stop()
+
+			return findUser(client, project, params.user)
+		}()
+		cmdutil.ExitIfError(err)
+
+		user = u
+	}
+
+	issues, total, err := cmdcommon.BulkIssues(client, project, params.bulk)
+	cmdutil.ExitIfError(err)
+
+	if len(issues) == 0 {
+		cmdutil.Failed("No issues found to assign")
+		return
+	}
+	if params.bulk.DryRun {
+		action := fmt.Sprintf("assign to %q", uname)
+		if assignee == jira.AssigneeNone {
+			action = "unassign"
+		}
+		cmdcommon.PrintBulkDryRun(issues, total, action)
+		return
+	}
+
+	keys := cmdcommon.BulkIssueKeys(issues)
+
+	done, err := func() (int, error) {
+		msg := fmt.Sprintf("Assigning %d issue(s) to user %q...", len(keys), uname)
+		if assignee == jira.AssigneeNone {
+			msg = fmt.Sprintf("Unassigning user from %d issue(s)...", len(keys))
+		}
+		s := cmdutil.Info(msg)
+		defer s.Stop()
+
+		return cmdutil.RunBulk(keys, int(params.bulk.Workers), func(key string) error {
+			return api.ProxyAssignIssue(client, key, user, assignee)
+		})
+	}()
+	if done > 0 {
+		if assignee == jira.AssigneeNone {
+			cmdutil.Success("User unassigned from %d of %d issue(s)", done, len(keys))
+		} else {
+			cmdutil.Success("User %q assigned to %d of %d issue(s)", uname, done, len(keys))
+		}
+	}
+	cmdutil.ExitIfError(err)
+}
+
+// findUser looks for an active user assignable to the project with the exact email or name.
+func findUser(client *jira.Client, project, name string) (*jira.User, error) {
+	users, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
+		Query:   name,
+		Project: project,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range users {
+		if strings.EqualFold(u.Email, name) || strings.EqualFold(u.Name, name) || strings.EqualFold(u.DisplayName, name) {
+			if !u.Active {
+				return nil, fmt.Errorf("user %q is not active", name)
+			}
+			return u, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid assignee %q", name)
+}
+
+type assignParams struct {
+	user string
+	bulk *cmdcommon.BulkParams
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *assignParams {
+	return &assignParams{
+		user: args[0],
+		bulk: cmdcommon.GetBulkParams(flags),
+	}
+}
@@ -0,0 +1,37 @@
+package bulk
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/bulk/assign"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/bulk/edit"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/bulk/move"
+)
+
+const helpText = `Bulk applies the same edit, transition or assignment to multiple issues at once.
+
+Issues are either selected with a JQL query using --jql or passed as a list of
+issue keys through the standard input. Use --dry-run to preview the affected issues.`
+
+// NewCmdBulk is a bulk command.
+func NewCmdBulk() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "bulk",
+		Short:   "Bulk edit, move or assign multiple issues at once",
+		Long:    helpText,
+		Aliases: []string{"batch"},
+		RunE:    bulk,
+	}
+
+	cmd.AddCommand(
+		edit.NewCmdEdit(),
+		move.NewCmdMove(),
+		assign.NewCmdAssign(),
+	)
+
+	return &cmd
+}
+
+func bulk(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,155 @@
+package edit
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Edit applies the same changes to all the matching issues.
+
+Labels, components and versions are added to the existing ones. Prefix
+the value with a minus sign to remove it instead.`
+	examples = `$ jira issue bulk edit --jql "sprint in openSprints() AND labels = backend" --priority High
+
+# Add a label and remove another from the issues
+$ jira issue bulk edit -q "status = 'To Do'" -lfrontend -l-backend
+
+# Pass issue keys through the standard input
+$ jira issue list --plain --no-headers --columns key -s"To Do" | jira issue bulk edit --fix-version v2.0
+
+# Preview the issues that would be updated
+$ jira issue bulk edit -q "assignee is EMPTY" --component API --dry-run`
+)
+
+// NewCmdEdit is a bulk edit command.
+func NewCmdEdit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "edit",
+		Short:   "Edit multiple issues at once",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"update", "modify"},
+		Run:     edit,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmdcommon.SetBulkFlags(&cmd)
+
+	cmd.Flags().StringP("priority", "y", "", "Edit priority")
+	cmd.Flags().StringArrayP("label", "l", []string{}, "Append labels")
+	cmd.Flags().StringArrayP("component", "C", []string{}, "Append components")
+	cmd.Flags().StringArray("fix-version", []string{}, "Add/Append release info (fixVersions)")
+	cmd.Flags().StringArray("affects-version", []string{}, "Add/Append release info (affectsVersions)")
+	cmd.Flags().StringToString("custom", map[string]string{}, "Edit custom fields")
+	cmd.Flags().Bool("skip-notify", false, "Do not notify watchers about the issue update")
+
+	return &cmd
+}
+
+func edit(cmd *cobra.Command, _ []string) {
+	project := viper.GetString("project.key")
+	params := parseArgsAndFlags(cmd.Flags())
+	client := api.DefaultClient(params.bulk.Debug)
+
+	edr := jira.EditRequest{
+		Priority:        params.priority,
+		Labels:          params.labels,
+		Components:      params.components,
+		FixVersions:     params.fixVersions,
+		AffectsVersions: params.affectsVersions,
+		CustomFields:    params.customFields,
+		SkipNotify:      params.skipNotify,
+	}
+	if edr.Priority == "" && len(edr.Labels) == 0 && len(edr.Components) == 0 && len(edr.FixVersions) == 0 &&
+		len(edr.AffectsVersions) == 0 && len(edr.CustomFields) == 0 {
+		cmdutil.Failed("Error: nothing to edit, use flags like --priority or --label to set the changes to apply")
+	}
+	if configuredCustomFields, err := cmdcommon.GetConfiguredCustomFields(); err == nil {
+		cmdcommon.ValidateCustomFields(edr.CustomFields, configuredCustomFields)
+		edr.WithCustomFields(configuredCustomFields)
+	}
+
+	issues, total, err := cmdcommon.BulkIssues(client, project, params.bulk)
+	cmdutil.ExitIfError(err)
+
+	if len(issues) == 0 {
+		cmdutil.Failed("No issues found to edit")
+		return
+	}
+	if params.bulk.DryRun {
+		cmdcommon.PrintBulkDryRun(issues, total, "edit")
+		return
+	}
+
+	keys := cmdcommon.BulkIssueKeys(issues)
+
+	done, err := func() (int, error) {
+		s := cmdutil.Info(fmt.Sprintf("Updating %d issue(s)...", len(keys)))
+		defer s.Stop()
+
+		return cmdutil.RunBulk(keys, int(params.bulk.Workers), func(key string) error {
+			// Each request gets its own copy as the request data is prepared in place.
+			req := edr
+			return api.ProxyEdit(client, key, &req)
+		})
+	}()
+	if done > 0 {
+		cmdutil.Success("Updated %d of %d issue(s)", done, len(keys))
+	}
+	cmdutil.ExitIfError(err)
+}
+
+type editParams struct {
+	priority        string
+	labels          []string
+	components      []string
+	fixVersions     []string
+	affectsVersions []string
+	customFields    map[string]string
+	skipNotify      bool
+	bulk            *cmdcommon.BulkParams
+}
+
+func parseArgsAndFlags(flags query.FlagParser) *editParams {
+	priority, err := flags.GetString("priority")
+	cmdutil.ExitIfError(err)
+
+	labels, err := flags.GetStringArray("label")
+	cmdutil.ExitIfError(err)
+
+	components, err := flags.GetStringArray("component")
+	cmdutil.ExitIfError(err)
+
+	fixVersions, err := flags.GetStringArray("fix-version")
+	cmdutil.ExitIfError(err)
+
+	affectsVersions, err := flags.GetStringArray("affects-version")
+	cmdutil.ExitIfError(err)
+
+	customFields, err := flags.GetStringToString("custom")
+	cmdutil.ExitIfError(err)
+
+	skipNotify, err := flags.GetBool("skip-notify")
+	cmdutil.ExitIfError(err)
+
+	return &editParams{
+		priority:        priority,
+		labels:          labels,
+		components:      components,
+		fixVersions:     fixVersions,
+		affectsVersions: affectsVersions,
+		customFields:    customFields,
+		skipNotify:      skipNotify,
+		bulk:            cmdcommon.GetBulkParams(flags),
+	}
+}
@@ -0,0 +1,165 @@
+package move
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Move transitions all the matching issues to the given state.`
+	examples = `$ jira issue bulk move Done --jql "sprint in closedSprints() AND status != Done"
+
+# Transition with a comment and a resolution
+$ jira issue bulk move Done -q "labels = wontfix" --comment "Closing stale issues" --resolution "Won't Do"
+
+# Pass issue keys through the standard input
+$ echo "ISSUE-1 ISSUE-2 ISSUE-3" | jira issue bulk move "In Progress"
+
+# Preview the issues that would be transitioned
+$ jira issue bulk move Done -q "status = 'In Review'" --dry-run`
+)
+
+// NewCmdMove is a bulk move command.
+func NewCmdMove() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "move STATE",
+		Short:   "Transition multiple issues to a given state",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"transition", "mv"},
+		Annotations: map[string]string{
+			"help:args": "STATE\tState you want to transition the issues to",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  move,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmdcommon.SetBulkFlags(&cmd)
+
+	cmd.Flags().String("comment", "", "Add comment to the issues")
+	cmd.Flags().StringP("resolution", "R", "", "Set resolution")
+
+	return &cmd
+}
+
+func move(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	installation := viper.GetString("installation")
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.bulk.Debug)
+
+	issues, total, err := cmdcommon.BulkIssues(client, project, params.bulk)
+	cmdutil.ExitIfError(err)
+
+	if len(issues) == 0 {
+		cmdutil.Failed("No issues found to transition")
+		return
+	}
+	if params.bulk.DryRun {
+		cmdcommon.PrintBulkDryRun(issues, total, fmt.Sprintf("transition to %q", params.state))
+		return
+	}
+
+	trFieldsReq := jira.TransitionRequestFields{}
+	trUpdateReq := jira.TransitionRequestUpdate{}
+
+	if params.resolution != "" {
+		trFieldsReq.Resolution = &struct {
+			Name string `json:"name"`
+		}{Name: params.resolution}
+	}
+	if params.comment != "" {
+		trUpdateReq.Comment = []struct {
+			Add struct {
+				Body string `json:"body"`
+			} `json:"add"`
+		}{
+			{Add: struct {
+				Body string `json:"body"`
+			}{Body: params.comment}},
+		}
+	}
+	fieldsMarshaler := jira.NewTransitionFieldsMarshaler(trFieldsReq, nil)
+
+	keys := cmdcommon.BulkIssueKeys(issues)
+
+	done, err := func() (int, error) {
+		s := cmdutil.Info(fmt.Sprintf("Transitioning %d issue(s) to %q...", len(keys), params.state))
+		defer s.Stop()
+
+		return cmdutil.RunBulk(keys, int(params.bulk.Workers), func(key string) error {
+			transitions, err := api.ProxyTransitions(client, key)
+			if err != nil {
+				return err
+			}
+
+			tr, err := findTransition(transitions, params.state, installation)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.Transition(key, &jira.TransitionRequest{
+				Fields: fieldsMarshaler,
+				Update: &trUpdateReq,
+				Transition: &jira.TransitionRequestData{
+					ID:   tr.ID.String(),
+					Name: tr.Name,
+				},
+			})
+			return err
+		})
+	}()
+	if done > 0 {
+		cmdutil.Success("Transitioned %d of %d issue(s) to state %q", done, len(keys), params.state)
+	}
+	cmdutil.ExitIfError(err)
+}
+
+// findTransition finds the transition to the state from the transitions available for an issue.
+func findTransition(transitions []*jira.Transition, state, it string) (*jira.Transition, error) {
+	all := make([]string, 0, len(transitions))
+	for _, t := range transitions {
+		if strings.EqualFold(t.Name, state) {
+			// Jira API v2 doesn't return "isAvailable" field, so it is only verified for the cloud installation.
+			if it == jira.InstallationTypeCloud && !t.IsAvailable {
+				return nil, fmt.Errorf("transition state %q is not available", state)
+			}
+			return t, nil
+		}
+		all = append(all, fmt.Sprintf("'%s'", t.Name))
+	}
+	return nil, fmt.Errorf("invalid transition state %q, available states: %s", state, strings.Join(all, ", "))
+}
+
+type moveParams struct {
+	state      string
+	comment    string
+	resolution string
+	bulk       *cmdcommon.BulkParams
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *moveParams {
+	comment, err := flags.GetString("comment")
+	cmdutil.ExitIfError(err)
+
+	resolution, err := flags.GetString("resolution")
+	cmdutil.ExitIfError(err)
+
+	return &moveParams{
+		state:      args[0],
+		comment:    comment,
+		resolution: resolution,
+		bulk:       cmdcommon.GetBulkParams(flags),
+	}
+}
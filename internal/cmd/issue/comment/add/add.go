@@ -2,6 +2,7 @@ package add
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -12,6 +13,7 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
 	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
 )
 
@@ -34,6 +36,12 @@ $ jira issue comment add ISSUE-1 --template -
 # Or, use pipe to read input directly from standard input
 $ echo "Comment from stdin" | jira issue comment add ISSUE-1
 
+# Add a comment to the issue of the current git branch, eg: feature/ISSUE-1-fix-login
+$ jira issue comment add "My comment"
+
+# Reply to an existing comment by quoting it
+$ jira issue comment add ISSUE-1 "I agree" --quote 10100
+
 # Positional argument takes precedence over the template flag
 # The example below will add "comment from arg" as a comment
 $ jira issue comment add ISSUE-1 "comment from arg" --template /path/to/template.tmpl`
@@ -47,7 +55,7 @@ func NewCmdCommentAdd() *cobra.Command {
 		Long:    helpText,
 		Example: examples,
 		Annotations: map[string]string{
-			"help:args": "ISSUE-KEY\tIssue key of the source issue, eg: ISSUE-1\n" +
+			"help:args": "ISSUE-KEY\tIssue key of the source issue, eg: ISSUE-1. Defaults to the issue key in the current git branch name\n" +
 				"COMMENT_BODY\tBody of the comment you want to add",
 		},
 		Run: add,
@@ -57,6 +65,7 @@ func NewCmdCommentAdd() *cobra.Command {
 	cmd.Flags().StringP("template", "T", "", "Path to a file to read comment body from")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
 	cmd.Flags().Bool("internal", false, "Make comment internal")
+	cmd.Flags().String("quote", "", "ID of the comment to quote in the reply")
 
 	return &cmd
 }
@@ -79,6 +88,7 @@ func add(cmd *cobra.Command, args []string) {
 	}
 
 	cmdutil.ExitIfError(ac.setIssueKey())
+	cmdutil.ExitIfError(ac.setQuote())
 
 	qs := ac.getQuestions()
 	if len(qs) > 0 {
@@ -103,7 +113,7 @@ func add(cmd *cobra.Command, args []string) {
 		s := cmdutil.Info("Adding comment")
 		defer s.Stop()
 
-		return client.AddIssueComment(ac.params.issueKey, ac.params.body, ac.params.internal)
+		return api.ProxyAddIssueComment(client, ac.params.issueKey, ac.params.body, ac.params.internal)
 	}()
 	cmdutil.ExitIfError(err)
 
@@ -122,20 +132,18 @@ type addParams struct {
 	issueKey string
 	body     string
 	template string
+	quote    string
 	noInput  bool
 	internal bool
 	debug    bool
 }
 
 func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
-	var issueKey, body string
+	var body string
 
-	nargs := len(args)
-	if nargs >= 1 {
-		issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
-	}
-	if nargs >= 2 {
-		body = args[1]
+	issueKey, rest := cmdutil.GetJiraIssueKeyFromArgs(viper.GetString("project.key"), args, 2)
+	if len(rest) >= 1 {
+		body = rest[0]
 	}
 
 	debug, err := flags.GetBool("debug")
@@ -150,10 +158,14 @@ func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
 	internal, err := flags.GetBool("internal")
 	cmdutil.ExitIfError(err)
 
+	quote, err := flags.GetString("quote")
+	cmdutil.ExitIfError(err)
+
 	return &addParams{
 		issueKey: issueKey,
 		body:     body,
 		template: template,
+		quote:    quote,
 		noInput:  noInput,
 		internal: internal,
 		debug:    debug,
@@ -164,6 +176,7 @@ type addCmd struct {
 	client    *jira.Client
 	linkTypes []*jira.IssueLinkType
 	params    *addParams
+	quoted    string
 }
 
 func (ac *addCmd) setIssueKey() error {
@@ -186,6 +199,47 @@ func (ac *addCmd) setIssueKey() error {
 	return nil
 }
 
+func (ac *addCmd) setQuote() error {
+	if ac.params.quote == "" {
+		return nil
+	}
+
+	comment, err := func() (*jira.Comment, error) {
+		s := cmdutil.Info("Fetching comment to quote...")
+		defer s.Stop()
+
+		return ac.client.GetIssueComment(ac.params.issueKey, ac.params.quote)
+	}()
+	if err != nil {
+		return err
+	}
+	ac.quoted = quoteComment(comment)
+
+	// Quote is prepended to the body if the body is passed directly.
+	if ac.params.body != "" {
+		ac.params.body = ac.quoted + ac.params.body
+	}
+
+	return nil
+}
+
+func quoteComment(c *jira.Comment) string {
+	author := c.Author.DisplayName
+	if author == "" {
+		author = c.Author.Name
+	}
+
+	var out strings.Builder
+
+	out.WriteString(fmt.Sprintf("> **%s** wrote:\n>\n", author))
+	for _, line := range strings.Split(strings.TrimSpace(md.FromJiraMD(c.Body)), "\n") {
+		out.WriteString(strings.TrimRight("> "+line, " ") + "\n")
+	}
+	out.WriteString("\n")
+
+	return out.String()
+}
+
 func (ac *addCmd) getQuestions() []*survey.Question {
 	var (
 		qs          []*survey.Question
@@ -199,6 +253,9 @@ func (ac *addCmd) getQuestions() []*survey.Question {
 		}
 		defaultBody = string(b)
 	}
+	if ac.quoted != "" && ac.params.body == "" {
+		defaultBody = ac.quoted + defaultBody
+	}
 
 	if ac.params.noInput && ac.params.body == "" {
 		ac.params.body = defaultBody
@@ -4,6 +4,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/comment/add"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/comment/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/comment/edit"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/comment/list"
 )
 
 const helpText = `Comment command helps you manage issue comments. See available commands below.`
@@ -18,7 +21,12 @@ func NewCmdComment() *cobra.Command {
 		RunE:    comment,
 	}
 
-	cmd.AddCommand(add.NewCmdCommentAdd())
+	cmd.AddCommand(
+		add.NewCmdCommentAdd(),
+		list.NewCmdCommentList(),
+		edit.NewCmdCommentEdit(),
+		delete.NewCmdCommentDelete(),
+	)
 
 	return &cmd
 }
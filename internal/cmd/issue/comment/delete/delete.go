@@ -0,0 +1,51 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Delete removes a comment from an issue.`
+	examples = `$ jira issue comment delete ISSUE-1 10100`
+)
+
+// NewCmdCommentDelete is a comment delete command.
+func NewCmdCommentDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete ISSUE-KEY COMMENT_ID",
+		Short:   "Delete a comment from an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"COMMENT_ID\tID of the comment, see 'jira issue comment list'",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  del,
+	}
+}
+
+func del(cmd *cobra.Command, args []string) {
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	id := args[1]
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Removing comment %q", id))
+		defer s.Stop()
+
+		return api.DefaultClient(debug).DeleteIssueComment(key, id)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Comment %q removed from issue %q", id, key)
+}
@@ -0,0 +1,224 @@
+package edit
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const (
+	helpText = `Edit updates an existing comment of an issue.
+
+The current comment body is opened in your editor if the new body is not provided.`
+	examples = `$ jira issue comment edit ISSUE-1 10100
+
+# Pass the new body to skip the editor
+$ jira issue comment edit ISSUE-1 10100 "Updated comment"
+
+# Load comment body from a template file
+$ jira issue comment edit ISSUE-1 10100 --template /path/to/template.tmpl
+
+# Or, use pipe to read input directly from standard input
+$ echo "Updated comment from stdin" | jira issue comment edit ISSUE-1 10100`
+)
+
+// NewCmdCommentEdit is a comment edit command.
+func NewCmdCommentEdit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "edit ISSUE-KEY COMMENT_ID [COMMENT_BODY]",
+		Short:   "Edit a comment of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"update"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"COMMENT_ID\tID of the comment, see 'jira issue comment list'\n" +
+				"COMMENT_BODY\tNew body of the comment",
+		},
+		Args: cobra.RangeArgs(2, 3),
+		Run:  edit,
+	}
+
+	cmd.Flags().Bool("web", false, "Open issue in web browser after updating comment")
+	cmd.Flags().StringP("template", "T", "", "Path to a file to read comment body from")
+	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+
+	return &cmd
+}
+
+func edit(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(args, cmd.Flags())
+	client := api.DefaultClient(params.debug)
+	ec := editCmd{
+		client: client,
+		params: params,
+	}
+
+	if ec.isNonInteractive() {
+		ec.params.noInput = true
+	}
+
+	qs, err := ec.getQuestions()
+	cmdutil.ExitIfError(err)
+
+	if len(qs) > 0 {
+		ans := struct{ Body string }{}
+		err := survey.Ask(qs, &ans)
+		cmdutil.ExitIfError(err)
+
+		params.body = ans.Body
+	}
+
+	if params.body == "" {
+		cmdutil.Failed("Comment body cannot be empty")
+	}
+
+	if !params.noInput {
+		answer := struct{ Action string }{}
+		err := survey.Ask([]*survey.Question{getNextAction()}, &answer)
+		cmdutil.ExitIfError(err)
+
+		if answer.Action == cmdcommon.ActionCancel {
+			cmdutil.Failed("Action aborted")
+		}
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Updating comment")
+		defer s.Stop()
+
+		return api.ProxyUpdateIssueComment(client, params.issueKey, params.commentID, params.body)
+	}()
+	cmdutil.ExitIfError(err)
+
+	server := viper.GetString("server")
+
+	cmdutil.Success("Comment %q of issue %q updated", params.commentID, params.issueKey)
+	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, params.issueKey))
+
+	if web, _ := cmd.Flags().GetBool("web"); web {
+		err := cmdutil.Navigate(server, params.issueKey)
+		cmdutil.ExitIfError(err)
+	}
+}
+
+type editParams struct {
+	issueKey  string
+	commentID string
+	body      string
+	template  string
+	noInput   bool
+	debug     bool
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *editParams {
+	var body string
+
+	issueKey := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	commentID := args[1]
+
+	if len(args) >= 3 {
+		body = args[2]
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	template, err := flags.GetString("template")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	return &editParams{
+		issueKey:  issueKey,
+		commentID: commentID,
+		body:      body,
+		template:  template,
+		noInput:   noInput,
+		debug:     debug,
+	}
+}
+
+type editCmd struct {
+	client *jira.Client
+	params *editParams
+}
+
+func (ec *editCmd) getQuestions() ([]*survey.Question, error) {
+	var (
+		qs          []*survey.Question
+		defaultBody string
+	)
+
+	if ec.params.body != "" {
+		return qs, nil
+	}
+
+	if ec.params.template != "" || cmdutil.StdinHasData() {
+		b, err := cmdutil.ReadFile(ec.params.template)
+		if err != nil {
+			return nil, err
+		}
+		defaultBody = string(b)
+	} else {
+		comment, err := func() (*jira.Comment, error) {
+			s := cmdutil.Info("Fetching comment...")
+			defer s.Stop()
+
+			return ec.client.GetIssueComment(ec.params.issueKey, ec.params.commentID)
+		}()
+		if err != nil {
+			return nil, err
+		}
+		defaultBody = md.FromJiraMD(comment.Body)
+	}
+
+	if ec.params.noInput {
+		ec.params.body = defaultBody
+		return qs, nil
+	}
+
+	qs = append(qs, &survey.Question{
+		Name: "body",
+		Prompt: &surveyext.JiraEditor{
+			Editor: &survey.Editor{
+				Message:       "Comment body",
+				Default:       defaultBody,
+				HideDefault:   true,
+				AppendDefault: true,
+			},
+			BlankAllowed: false,
+		},
+	})
+
+	return qs, nil
+}
+
+func getNextAction() *survey.Question {
+	return &survey.Question{
+		Name: "action",
+		Prompt: &survey.Select{
+			Message: "What's next?",
+			Options: []string{
+				cmdcommon.ActionSubmit,
+				cmdcommon.ActionCancel,
+			},
+		},
+		Validate: survey.Required,
+	}
+}
+
+func (ec *editCmd) isNonInteractive() bool {
+	return cmdutil.StdinHasData() || ec.params.template == "-"
+}
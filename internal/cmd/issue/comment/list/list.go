@@ -0,0 +1,125 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `List lists comments of an issue.`
+	examples = `$ jira issue comment list ISSUE-1
+
+# Load comments 20 to 30
+$ jira issue comment list ISSUE-1 --paginate 20:10
+
+# Display output in plain mode without truncating the body
+$ jira issue comment list ISSUE-1 --plain --no-truncate
+
+# Display output in CSV or JSON format
+$ jira issue comment list ISSUE-1 --csv
+$ jira issue comment list ISSUE-1 --raw`
+)
+
+// NewCmdCommentList is a comment list command.
+func NewCmdCommentList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list ISSUE-KEY",
+		Short:   "List comments of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Annotations: map[string]string{
+			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1`,
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  list,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("paginate", "0:100", "Paginate the result. Max 100 at a time, format: <from>:<limit> where <from> is optional")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+	cmd.Flags().Bool("no-truncate", false, "Show full comment body in plain mode. Works only with --plain")
+	cmd.Flags().String("delimiter", "\t", "Custom delimeter for columns in plain mode. Works only with --plain")
+	cmd.Flags().Bool("raw", false, "Print raw JSON output")
+	cmd.Flags().Bool("csv", false, "Print output in CSV format")
+
+	return &cmd
+}
+
+func list(cmd *cobra.Command, args []string) {
+	server := viper.GetString("server")
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	from, limit, err := query.Paginate(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	res, err := func() (*jira.CommentResult, error) {
+		s := cmdutil.Info("Fetching comments...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).GetIssueComments(key, from, limit)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(res.Comments) == 0 {
+		cmdutil.Failed("No comments found in issue %q", key)
+		return
+	}
+
+	raw, err := cmd.Flags().GetBool("raw")
+	cmdutil.ExitIfError(err)
+
+	if raw {
+		data, err := json.MarshalIndent(res.Comments, "", "  ")
+		cmdutil.ExitIfError(err)
+
+		fmt.Println(string(data))
+		return
+	}
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	delimiter, err := cmd.Flags().GetString("delimiter")
+	cmdutil.ExitIfError(err)
+
+	csv, err := cmd.Flags().GetBool("csv")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	noTruncate, err := cmd.Flags().GetBool("no-truncate")
+	cmdutil.ExitIfError(err)
+
+	v := view.CommentList{
+		Issue:  key,
+		Server: server,
+		Total:  res.Total,
+		Data:   res.Comments,
+		Display: view.DisplayFormat{
+			Plain:      plain,
+			Delimiter:  delimiter,
+			CSV:        csv,
+			NoHeaders:  noHeaders,
+			NoTruncate: noTruncate,
+			Timezone:   viper.GetString("timezone"),
+		},
+	}
+
+	cmdutil.ExitIfError(v.Render())
+}
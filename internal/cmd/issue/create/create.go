@@ -3,6 +3,8 @@ package create
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -12,6 +14,7 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/template"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
@@ -34,6 +37,10 @@ $ jira issue create -tStory -s"Issue with custom fields" --custom story-points=3
 # Load description from template file
 $ jira issue create --template /path/to/template.tmpl
 
+# Create issue from an issue template, see 'jira template list'
+# The values of the template variables are prompted if not passed
+$ jira issue create --template bug --var component=API --var title="Login fails"
+
 # Get description from standard input
 $ jira issue create --template -
 
@@ -68,6 +75,9 @@ func NewCmdCreate() *cobra.Command {
 // SetFlags sets flags supported by create command.
 func SetFlags(cmd *cobra.Command) {
 	cmdcommon.SetCreateFlags(cmd, "Issue")
+
+	cmd.Flags().Lookup("template").Usage = "Path or name of an issue template to read the issue details or description from"
+	cmd.Flags().StringArray("var", []string{}, "Set a template variable, eg: --var component=API")
 }
 
 func create(cmd *cobra.Command, _ []string) {
@@ -85,12 +95,17 @@ func create(cmd *cobra.Command, _ []string) {
 
 	if cc.isNonInteractive() || cc.params.NoInput || tui.IsDumbTerminal() {
 		cc.params.NoInput = true
+	}
 
-		if cc.isMandatoryParamsMissing() {
-			cmdutil.Failed(
-				"Params `--summary` and `--type` is mandatory when using a non-interactive mode",
-			)
-		}
+	vars, err := cmd.Flags().GetStringArray("var")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cc.applyTemplate(vars))
+	cmdcommon.SetCreateDefaults(params)
+
+	if cc.params.NoInput && cc.isMandatoryParamsMissing() {
+		cmdutil.Failed(
+			"Params `--summary` and `--type` is mandatory when using a non-interactive mode",
+		)
 	}
 
 	cmdutil.ExitIfError(cc.setIssueTypes())
@@ -169,11 +184,13 @@ func create(cmd *cobra.Command, _ []string) {
 			cr.SubtaskField = handle
 		}
 
-		return client.CreateV2(&cr)
+		return api.ProxyCreate(client, &cr)
 	}()
 
 	cmdutil.ExitIfError(err)
 
+	subtasks := cc.createSubtasks(issue.Key, projectType, installation)
+
 	jsonFlag, err := cmd.Flags().GetBool(flagRaw)
 	cmdutil.ExitIfError(err)
 	if jsonFlag {
@@ -184,6 +201,9 @@ func create(cmd *cobra.Command, _ []string) {
 	}
 
 	cmdutil.Success("Issue created\n%s", cmdutil.GenerateServerBrowseURL(server, issue.Key))
+	for _, key := range subtasks {
+		cmdutil.Success("Sub-task created\n%s", cmdutil.GenerateServerBrowseURL(server, key))
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, issue.Key)
@@ -195,6 +215,148 @@ type createCmd struct {
 	client     *jira.Client
 	issueTypes []*jira.IssueType
 	params     *cmdcommon.CreateParams
+	template   *template.Template
+}
+
+// applyTemplate sets the params that are not passed using the flags from the issue template.
+// The values of the template variables that are not passed using --var are prompted.
+func (cc *createCmd) applyTemplate(vars []string) error {
+	if cc.params.Template == "" || cc.params.Template == "-" {
+		return nil
+	}
+
+	tmpl, err := template.Load(cc.params.Template)
+	if err != nil {
+		return err
+	}
+	if tmpl.IsPlain() {
+		return nil
+	}
+
+	values := make(map[string]string, len(vars))
+	for _, v := range vars {
+		key, val, ok := strings.Cut(v, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return fmt.Errorf("invalid template variable %q, expected key=value", v)
+		}
+		values[strings.TrimSpace(key)] = val
+	}
+	if !cc.params.NoInput {
+		if err := askTemplateVars(tmpl, values); err != nil {
+			return err
+		}
+	}
+
+	tmpl, err = tmpl.Render(values)
+	if err != nil {
+		return err
+	}
+
+	p := cc.params
+	if p.IssueType == "" {
+		p.IssueType = tmpl.Type
+	}
+	if p.Summary == "" {
+		p.Summary = tmpl.Summary
+	}
+	if p.Priority == "" {
+		p.Priority = tmpl.Priority
+	}
+	for _, l := range tmpl.Labels {
+		if !slices.Contains(p.Labels, l) {
+			p.Labels = append(p.Labels, l)
+		}
+	}
+	for _, c := range tmpl.Components {
+		if !slices.Contains(p.Components, c) {
+			p.Components = append(p.Components, c)
+		}
+	}
+	for k, v := range tmpl.CustomFields {
+		if p.CustomFields == nil {
+			p.CustomFields = make(map[string]string)
+		}
+		if _, ok := p.CustomFields[k]; !ok {
+			p.CustomFields[k] = v
+		}
+	}
+
+	// The description is taken from the rendered template instead of the file.
+	p.Template = ""
+	cc.template = tmpl
+
+	return nil
+}
+
+func askTemplateVars(tmpl *template.Template, values map[string]string) error {
+	for _, name := range tmpl.Variables() {
+		if _, ok := values[name]; ok {
+			continue
+		}
+
+		var (
+			v   = tmpl.Vars[name]
+			ans string
+			in  = &survey.Input{Message: name}
+			qs  = &survey.Question{Name: name, Prompt: in, Validate: survey.Required}
+		)
+		if v != nil {
+			in.Help = v.Description
+			in.Default = v.Default
+		}
+		if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+			return err
+		}
+		values[name] = ans
+	}
+	return nil
+}
+
+// createSubtasks creates the sub-tasks defined in the issue template and returns their keys.
+// Failures are reported but don't abort the command as the issue is already created.
+func (cc *createCmd) createSubtasks(parent, projectType, installation string) []string {
+	if cc.template == nil || len(cc.template.Subtasks) == 0 {
+		return nil
+	}
+
+	handle := cmdutil.GetSubtaskHandle(jira.IssueTypeSubTask, cc.issueTypes)
+
+	var typeID string
+	for _, t := range cc.issueTypes {
+		if t.Subtask && (t.Handle == handle || t.Name == handle) {
+			typeID = t.ID
+			break
+		}
+	}
+
+	keys := make([]string, 0, len(cc.template.Subtasks))
+	for _, st := range cc.template.Subtasks {
+		resp, err := func() (*jira.CreateResponse, error) {
+			s := cmdutil.Info(fmt.Sprintf("Creating sub-task %q...", st.Summary))
+			defer s.Stop()
+
+			cr := jira.CreateRequest{
+				Project:        viper.GetString("project.key"),
+				IssueType:      handle,
+				IssueTypeID:    typeID,
+				ParentIssueKey: parent,
+				Summary:        st.Summary,
+				Body:           st.Body,
+				Labels:         st.Labels,
+				SubtaskField:   handle,
+			}
+			cr.ForProjectType(projectType)
+			cr.ForInstallationType(installation)
+
+			return api.ProxyCreate(cc.client, &cr)
+		}()
+		if err != nil {
+			cmdutil.Fail("Unable to create sub-task %q: %s", st.Summary, err)
+			continue
+		}
+		keys = append(keys, resp.Key)
+	}
+	return keys
 }
 
 func (cc *createCmd) setIssueTypes() error {
@@ -337,7 +499,9 @@ func (cc *createCmd) getRemainingQuestions() []*survey.Question {
 
 	var defaultBody string
 
-	if cc.params.Template != "" || cmdutil.StdinHasData() {
+	if cc.template != nil {
+		defaultBody = cc.template.Body
+	} else if cc.params.Template != "" || cmdutil.StdinHasData() {
 		b, err := cmdutil.ReadFile(cc.params.Template)
 		if err != nil {
 			cmdutil.Failed("Error: %s", err)
@@ -441,7 +605,7 @@ func parseFlags(flags query.FlagParser) *cmdcommon.CreateParams {
 		AffectsVersions:  affectsVersions,
 		OriginalEstimate: originalEstimate,
 		CustomFields:     custom,
-		Template:         template,
+		Template:         cmdcommon.ResolveTemplate(template),
 		NoInput:          noInput,
 		Debug:            debug,
 	}
@@ -14,7 +14,6 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/adf"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
-	"github.com/ankitpokhrel/jira-cli/pkg/md"
 	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
 )
 
@@ -79,15 +78,11 @@ func edit(cmd *cobra.Command, args []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
-	var (
-		isADF        bool
-		originalBody string
-	)
+	var originalBody string
 
 	if issue.Fields.Description != nil {
 		if adfBody, ok := issue.Fields.Description.(*adf.ADF); ok {
-			isADF = true
-			originalBody = adf.NewTranslator(adfBody, adf.NewJiraMarkdownTranslator()).Translate()
+			originalBody = adf.NewTranslator(adfBody, adf.NewMarkdownTranslator()).Translate()
 		} else {
 			originalBody = issue.Fields.Description.(string)
 		}
@@ -137,11 +132,6 @@ func edit(cmd *cobra.Command, args []string) {
 		s := cmdutil.Info("Updating an issue...")
 		defer s.Stop()
 
-		body := params.body
-		if isADF {
-			body = md.ToJiraMD(body)
-		}
-
 		parent := cmdutil.GetJiraIssueKey(project, params.parentIssueKey)
 		if parent == "" && issue.Fields.Parent != nil {
 			parent = issue.Fields.Parent.Key
@@ -150,7 +140,7 @@ func edit(cmd *cobra.Command, args []string) {
 		edr := jira.EditRequest{
 			ParentIssueKey:  parent,
 			Summary:         params.summary,
-			Body:            body,
+			Body:            params.body,
 			Priority:        params.priority,
 			Labels:          labels,
 			Components:      components,
@@ -164,7 +154,7 @@ func edit(cmd *cobra.Command, args []string) {
 			edr.WithCustomFields(configuredCustomFields)
 		}
 
-		return client.Edit(params.issueKey, &edr)
+		return api.ProxyEdit(client, params.issueKey, &edr)
 	}()
 	cmdutil.ExitIfError(err)
 
@@ -0,0 +1,291 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	exporter "github.com/ankitpokhrel/jira-cli/internal/export"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
+)
+
+const (
+	defaultWorkers = 5
+	maxWorkers     = 20
+
+	helpText = `Export fetches issues with their full details and writes them to a file for backups and audits.
+
+Along with the issue fields, comments, worklogs, links, the change history and the
+metadata of the attachments are exported. Descriptions and comments are converted to
+markdown. Issues are searched within the project using --jql, or all issues of the
+project are exported if no query is given.
+
+The json format writes a single list of issues, the ndjson format writes an issue
+per line and the csv format writes an issue per row with the comments, worklogs,
+links and attachments summarized. The markdown format writes a file per issue and
+an index.md file listing the issues to the directory given with --output.`
+	examples = `# Export all issues of the project as NDJSON
+$ jira issue export -o issues.ndjson
+
+# Export the issues resolved this year as JSON
+$ jira issue export -q "resolved >= startOfYear()" --format json -o resolved.json
+
+# Export the issues of an epic as markdown files
+$ jira issue export -q "parent = ISSUE-1" --format markdown -o ./ISSUE-1
+
+# Print the issues to the standard output
+$ jira issue export -q "status = Done" --format csv --limit 50`
+)
+
+// NewCmdExport is an export command.
+func NewCmdExport() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "export",
+		Short:   "Export issues with full details to JSON, NDJSON, CSV or Markdown",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"backup"},
+		Args:    cobra.NoArgs,
+		Run:     export,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringP("jql", "q", "", "Export the issues matching the JQL within the project")
+	cmd.Flags().String("format", exporter.FormatNDJSON, "Output format: json, ndjson, csv or markdown")
+	cmd.Flags().StringP("output", "o", "", "File to write the issues to, or the directory for the markdown format (standard output by default)")
+	cmd.Flags().Uint("limit", 0, "Maximum number of issues to export, 0 exports all matching issues")
+	cmd.Flags().Uint("workers", defaultWorkers, fmt.Sprintf("Number of issues to fetch concurrently (max %d)", maxWorkers))
+
+	return &cmd
+}
+
+func export(cmd *cobra.Command, _ []string) {
+	project := viper.GetString("project.key")
+	params := parseFlags(cmd.Flags())
+	client := api.DefaultClient(params.debug)
+
+	ec := exportCmd{
+		client:  client,
+		open:    params.writer,
+		workers: params.workers,
+	}
+
+	err := func() error {
+		s := cmdutil.Info("Exporting issues...")
+		defer s.Stop()
+
+		return ec.run(api.ProxySearchIterator(client, jql.WithProject(project, params.jql), 0, params.limit))
+	}()
+	if err != nil {
+		_ = ec.close()
+		cmdutil.ExitIfError(err)
+	}
+	cmdutil.ExitIfError(ec.close())
+
+	if ec.exported == 0 && ec.failed.Len() == 0 {
+		cmdutil.Failed("No result found for given query in project %q", project)
+		return
+	}
+	if params.output != "" {
+		cmdutil.Success("Exported %d issue(s) to %s", ec.exported, params.output)
+	}
+	if ec.failed.Len() > 0 {
+		cmdutil.ExitIfError(&jira.ErrMultipleFailed{Msg: ec.failed.String()})
+	}
+}
+
+type exportParams struct {
+	jql     string
+	format  string
+	output  string
+	limit   uint
+	workers uint
+	debug   bool
+}
+
+func parseFlags(flags query.FlagParser) *exportParams {
+	jql, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	format, err := flags.GetString("format")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	limit, err := flags.GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	workers, err := flags.GetUint("workers")
+	cmdutil.ExitIfError(err)
+
+	if workers < 1 || workers > maxWorkers {
+		cmdutil.Failed("Error: --workers must be between 1 and %d", maxWorkers)
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	format = strings.ToLower(format)
+	switch format {
+	case exporter.FormatJSON, exporter.FormatNDJSON, exporter.FormatCSV, exporter.FormatMarkdown:
+	default:
+		cmdutil.ExitIfError(exporter.ErrUnknownFormat)
+	}
+
+	if output == "-" {
+		output = ""
+	}
+
+	return &exportParams{
+		jql:     jql,
+		format:  format,
+		output:  output,
+		limit:   limit,
+		workers: workers,
+		debug:   debug,
+	}
+}
+
+// writer returns the writer for the export and a func to close it along with the output file.
+func (p *exportParams) writer() (exporter.Writer, func() error, error) {
+	if p.format == exporter.FormatMarkdown {
+		w, err := exporter.NewWriter(p.format, nil, p.output)
+		if err != nil {
+			return nil, nil, err
+		}
+		return w, w.Close, nil
+	}
+
+	var (
+		out       io.Writer = os.Stdout
+		closeFile           = func() error { return nil }
+	)
+
+	if p.output != "" {
+		f, err := os.Create(p.output)
+		if err != nil {
+			return nil, nil, err
+		}
+		out, closeFile = f, f.Close
+	}
+
+	w, err := exporter.NewWriter(p.format, out, "")
+	if err != nil {
+		_ = closeFile()
+		return nil, nil, err
+	}
+
+	return w, func() error {
+		if err := w.Close(); err != nil {
+			_ = closeFile()
+			return err
+		}
+		return closeFile()
+	}, nil
+}
+
+type exportCmd struct {
+	client   *jira.Client
+	open     func() (exporter.Writer, func() error, error)
+	writer   exporter.Writer
+	closeOut func() error
+	workers  uint
+	exported int
+	failed   strings.Builder
+}
+
+// run exports the issues page by page. The details of the issues in a page are fetched
+// concurrently and the issues are written in the order they are returned by the search.
+// The output is opened once the first page is returned so that an existing file is not
+// truncated if the search fails or doesn't match any issue.
+func (ec *exportCmd) run(it *jira.SearchIterator) error {
+	for it.Next() {
+		issues := it.Issues()
+		if len(issues) == 0 {
+			continue
+		}
+
+		if ec.writer == nil {
+			w, closeOut, err := ec.open()
+			if err != nil {
+				return err
+			}
+			ec.writer, ec.closeOut = w, closeOut
+		}
+
+		keys := make([]string, 0, len(issues))
+		pos := make(map[string]int, len(issues))
+		for i, iss := range issues {
+			keys = append(keys, iss.Key)
+			pos[iss.Key] = i
+		}
+
+		out := make([]*exporter.Issue, len(issues))
+
+		_, err := cmdutil.RunBulk(keys, int(ec.workers), func(key string) error {
+			iss, err := ec.fetch(key)
+			if err != nil {
+				return err
+			}
+			out[pos[key]] = iss
+			return nil
+		})
+		if e, ok := err.(*jira.ErrMultipleFailed); ok {
+			ec.failed.WriteString(e.Msg)
+		} else if err != nil {
+			return err
+		}
+
+		for _, iss := range out {
+			if iss == nil {
+				continue
+			}
+			if err := ec.writer.Write(iss); err != nil {
+				return err
+			}
+			ec.exported++
+		}
+	}
+
+	return it.Err()
+}
+
+// close closes the output if it was opened.
+func (ec *exportCmd) close() error {
+	if ec.closeOut == nil {
+		return nil
+	}
+	return ec.closeOut()
+}
+
+// fetch fetches the issue along with its comments, worklogs and change history.
+func (ec *exportCmd) fetch(key string) (*exporter.Issue, error) {
+	iss, err := api.ProxyGetIssue(ec.client, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var details exporter.Details
+
+	if details.Comments, err = ec.client.GetAllIssueComments(key); err != nil {
+		return nil, fmt.Errorf("unable to fetch comments: %w", err)
+	}
+	if details.Worklogs, err = ec.client.GetAllIssueWorklogs(key); err != nil {
+		return nil, fmt.Errorf("unable to fetch worklogs: %w", err)
+	}
+	if details.Changelog, err = api.ProxyGetIssueChangelog(ec.client, key); err != nil {
+		return nil, fmt.Errorf("unable to fetch history: %w", err)
+	}
+
+	return exporter.NewIssue(iss, &details), nil
+}
@@ -0,0 +1,163 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `History displays the change history of an issue.
+
+Each row shows a field change along with who changed it and when. Use --field to
+only show changes of the given fields, eg: status, assignee, sprint, story points.
+A field is matched against its name or id, eg: customfield_10016.`
+
+	examples = `$ jira issue history ISSUE-1
+
+# Show who moved the issue to a different status and when
+$ jira issue history ISSUE-1 --field status
+
+# Show changes to the assignee and story points
+$ jira issue history ISSUE-1 --field assignee --field "story points"
+
+# Display output in CSV or JSON format
+$ jira issue history ISSUE-1 --csv
+$ jira issue history ISSUE-1 --raw`
+)
+
+// NewCmdHistory is a history command.
+func NewCmdHistory() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "history ISSUE-KEY",
+		Short:   "Display the change history of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"changelog"},
+		Annotations: map[string]string{
+			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1`,
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  history,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringArrayP("field", "f", []string{}, "Only show changes of the given field name or id")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+	cmd.Flags().Bool("no-truncate", false, "Show full field values in plain mode. Works only with --plain")
+	cmd.Flags().String("delimiter", "\t", "Custom delimeter for columns in plain mode. Works only with --plain")
+	cmd.Flags().Bool("raw", false, "Print raw JSON output")
+	cmd.Flags().Bool("csv", false, "Print output in CSV format")
+
+	return &cmd
+}
+
+func history(cmd *cobra.Command, args []string) {
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	fields, err := cmd.Flags().GetStringArray("field")
+	cmdutil.ExitIfError(err)
+
+	histories, err := func() ([]*jira.ChangelogHistory, error) {
+		s := cmdutil.Info("Fetching issue history...")
+		defer s.Stop()
+
+		return api.ProxyGetIssueChangelog(api.DefaultClient(debug), key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	histories = filterByField(histories, fields)
+	if len(histories) == 0 {
+		cmdutil.Failed("No history found in issue %q", key)
+		return
+	}
+
+	raw, err := cmd.Flags().GetBool("raw")
+	cmdutil.ExitIfError(err)
+
+	if raw {
+		data, err := json.MarshalIndent(histories, "", "  ")
+		cmdutil.ExitIfError(err)
+
+		fmt.Println(string(data))
+		return
+	}
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	delimiter, err := cmd.Flags().GetString("delimiter")
+	cmdutil.ExitIfError(err)
+
+	csv, err := cmd.Flags().GetBool("csv")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	noTruncate, err := cmd.Flags().GetBool("no-truncate")
+	cmdutil.ExitIfError(err)
+
+	v := view.IssueHistory{
+		Issue: key,
+		Data:  histories,
+		Display: view.DisplayFormat{
+			Plain:      plain,
+			Delimiter:  delimiter,
+			CSV:        csv,
+			NoHeaders:  noHeaders,
+			NoTruncate: noTruncate,
+			Timezone:   viper.GetString("timezone"),
+		},
+	}
+
+	cmdutil.ExitIfError(v.Render())
+}
+
+// filterByField only keeps the changes of the given fields. The histories
+// without any matching changes are removed altogether.
+func filterByField(histories []*jira.ChangelogHistory, fields []string) []*jira.ChangelogHistory {
+	if len(fields) == 0 {
+		return histories
+	}
+
+	match := func(item *jira.ChangelogItem) bool {
+		return slices.ContainsFunc(fields, func(f string) bool {
+			f = strings.TrimSpace(f)
+			return strings.EqualFold(f, item.Field) || strings.EqualFold(f, item.FieldID)
+		})
+	}
+
+	out := make([]*jira.ChangelogHistory, 0, len(histories))
+	for _, h := range histories {
+		var items []*jira.ChangelogItem
+		for _, item := range h.Items {
+			if match(item) {
+				items = append(items, item)
+			}
+		}
+		if len(items) == 0 {
+			continue
+		}
+
+		filtered := *h
+		filtered.Items = items
+		out = append(out, &filtered)
+	}
+
+	return out
+}
@@ -0,0 +1,442 @@
+package imports
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/importer"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Import creates issues in bulk from a CSV, JSON or YAML file.
+
+Each row of a CSV file, or an item of a JSON or YAML list, is an issue with the
+fields: id, type, summary, body, priority, assignee, reporter, labels, components,
+fix-versions, affects-versions, original-estimate, parent, epic, name (epic name),
+links and custom fields. Custom fields are set in columns prefixed with "custom."
+in a CSV file, eg: custom.story-points, and under the "custom" key otherwise.
+
+The id is a temporary identifier that other issues in the file can reference as
+their parent, epic or link target. References that are not ids in the file are
+issue keys. Sub-tasks can also be nested under the "subtasks" key of an issue.
+Links are set as "TYPE:ISSUE" in a CSV file, eg: Blocks:S2.
+
+The issues are validated against the create metadata of the project before any
+issue is created. Use --dry-run to only validate the file and preview the import.`
+	examples = `$ jira issue import issues.csv
+
+# Validate the file and preview the issues that would be created
+$ jira issue import backlog.yml --dry-run
+
+# Save the ids and the keys of the created issues
+$ jira issue import issues.json --output mapping.csv
+
+# Read the issues from the standard input
+$ cat issues.csv | jira issue import - --format csv`
+)
+
+// NewCmdImport is an import command.
+func NewCmdImport() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "import FILE",
+		Short:   "Import issues from a CSV, JSON or YAML file",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "FILE\tPath to the CSV, JSON or YAML file, use - to read from the standard input",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  importIssues,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("format", "", "Format of the file: csv, json or yaml (detected from the file extension by default)")
+	cmd.Flags().StringP("output", "o", "", "Write the ids and the keys of the created issues to a CSV, JSON or YAML file")
+	cmd.Flags().Bool("dry-run", false, "Validate the file and list the issues that would be created")
+
+	return &cmd
+}
+
+func importIssues(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	issues, err := params.load()
+	cmdutil.ExitIfError(err)
+
+	if len(issues) == 0 {
+		cmdutil.Failed("No issues found to import")
+		return
+	}
+
+	issues, err = importer.Plan(issues, project)
+	cmdutil.ExitIfError(err)
+
+	err = func() error {
+		s := cmdutil.Info("Validating issues...")
+		defer s.Stop()
+
+		meta, err := getMeta(client, project)
+		if err != nil {
+			return err
+		}
+		return meta.Validate(issues)
+	}()
+	cmdutil.ExitIfError(err)
+
+	users := resolveUsers(client, project, issues)
+
+	if params.dryRun {
+		printDryRun(issues)
+		return
+	}
+
+	ic := importCmd{
+		client: client,
+		users:  users,
+	}
+
+	created, err := func() (int, error) {
+		s := cmdutil.Info(fmt.Sprintf("Importing %d issue(s)...", len(issues)))
+		defer s.Stop()
+
+		return ic.create(issues), ic.link(issues)
+	}()
+
+	if created > 0 {
+		printCreated(issues)
+		cmdutil.Success("Imported %d of %d issue(s)", created, len(issues))
+	}
+	if ic.linked > 0 {
+		cmdutil.Success("Created %d link(s)", ic.linked)
+	}
+	if params.output != "" && created > 0 {
+		if err := writeMapping(params.output, issues); err != nil {
+			cmdutil.Fail("Unable to write the mapping file: %s", err)
+		} else {
+			fmt.Printf("Created issue keys are saved to %s\n", params.output)
+		}
+	}
+	cmdutil.ExitIfError(err)
+}
+
+type importParams struct {
+	file   string
+	format string
+	output string
+	dryRun bool
+	debug  bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *importParams {
+	format, err := flags.GetString("format")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := flags.GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &importParams{
+		file:   args[0],
+		format: strings.ToLower(format),
+		output: output,
+		dryRun: dryRun,
+		debug:  debug,
+	}
+}
+
+func (p *importParams) load() ([]*importer.Issue, error) {
+	if p.file != "-" {
+		if p.format == "" {
+			return importer.Load(p.file)
+		}
+
+		f, err := os.Open(p.file)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+
+		return importer.Parse(f, p.format)
+	}
+
+	if p.format == "" {
+		return nil, fmt.Errorf("the format is required when reading from the standard input, use --format")
+	}
+	return importer.Parse(os.Stdin, p.format)
+}
+
+func getMeta(client *jira.Client, project string) (*importer.Meta, error) {
+	var (
+		issueTypes []*jira.CreateMetaIssueType
+		err        error
+	)
+
+	//nolint:mnd
+	isV9Compatible := viper.GetInt("version.major") >= 9 || (viper.GetInt("version.major") == 8 && viper.GetInt("version.minor") > 4)
+	if viper.GetString("installation") == jira.InstallationTypeLocal && isV9Compatible {
+		issueTypes, err = getIssueTypesForJiraServerV9(client, project)
+	} else {
+		issueTypes, err = getIssueTypes(client, project)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Custom fields are validated against the configured custom fields only if they are set.
+	configured, _ := cmdcommon.GetConfiguredCustomFields()
+
+	return &importer.Meta{
+		IssueTypes:   issueTypes,
+		CustomFields: configured,
+		EpicName:     viper.GetString("epic.name"),
+		EpicLink:     viper.GetString("epic.link"),
+	}, nil
+}
+
+func getIssueTypes(client *jira.Client, project string) ([]*jira.CreateMetaIssueType, error) {
+	res, err := client.GetCreateMeta(&jira.CreateMetaRequest{
+		Projects: project,
+		Expand:   "projects.issuetypes.fields",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Projects) == 0 {
+		return nil, fmt.Errorf("no project found for key: %s", project)
+	}
+	return res.Projects[0].IssueTypes, nil
+}
+
+// getIssueTypesForJiraServerV9 gets the issue types and their fields separately
+// as jira server 9 and above removed the fields from the issue types endpoint.
+func getIssueTypesForJiraServerV9(client *jira.Client, project string) ([]*jira.CreateMetaIssueType, error) {
+	res, err := client.GetCreateMetaForJiraServerV9(&jira.CreateMetaRequest{
+		Projects: project,
+		Expand:   "projects.issuetypes.fields",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Values) == 0 {
+		return nil, fmt.Errorf("no issue types found for project: %s", project)
+	}
+
+	issueTypes := make([]*jira.CreateMetaIssueType, 0, len(res.Values))
+	for _, it := range res.Values {
+		fields, err := client.GetCreateMetaFieldsForJiraServerV9(project, it.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		issueType := jira.CreateMetaIssueType{
+			IssueType: jira.IssueType{
+				ID:      it.ID,
+				Name:    it.Name,
+				Subtask: it.Subtask,
+			},
+			Fields: make(map[string]jira.IssueTypeField, len(fields)),
+		}
+		for _, f := range fields {
+			if f.Key == "" {
+				f.Key = f.FieldID
+			}
+			issueType.Fields[f.FieldID] = f
+		}
+		issueTypes = append(issueTypes, &issueType)
+	}
+
+	return issueTypes, nil
+}
+
+// resolveUsers resolves the assignees and the reporters of the issues before
+// importing so that an unknown user doesn't fail the import halfway through.
+func resolveUsers(client *jira.Client, project string, issues []*importer.Issue) map[string]string {
+	users := make(map[string]string)
+	for _, iss := range issues {
+		for _, u := range []string{iss.Assignee, iss.Reporter} {
+			if _, ok := users[u]; u == "" || ok {
+				continue
+			}
+			users[u] = cmdcommon.GetRelevantUser(client, project, u)
+		}
+	}
+	return users
+}
+
+type importCmd struct {
+	client *jira.Client
+	users  map[string]string
+	failed strings.Builder
+	linked int
+}
+
+func (ic *importCmd) fail(iss *importer.Issue, format string, a ...interface{}) {
+	ic.failed.WriteString(fmt.Sprintf("\n  - %s (%s): %s", iss.Pos, iss.Summary, fmt.Sprintf(format, a...)))
+}
+
+// create creates the issues in the planned order and returns the number of created issues.
+// Issues whose parent or epic in the file failed to be created are skipped.
+func (ic *importCmd) create(issues []*importer.Issue) int {
+	var (
+		project          = viper.GetString("project.key")
+		projectType      = viper.GetString("project.type")
+		installation     = viper.GetString("installation")
+		configured, cErr = cmdcommon.GetConfiguredCustomFields()
+		created          int
+	)
+
+	for _, iss := range issues {
+		parent, ok := iss.ParentKey()
+		if !ok {
+			ic.fail(iss, "skipped as its parent %s is not created", iss.ParentRef())
+			continue
+		}
+
+		cr := jira.CreateRequest{
+			Project:          project,
+			IssueType:        iss.IssueType(),
+			IssueTypeID:      iss.IssueTypeID(),
+			ParentIssueKey:   parent,
+			Summary:          iss.Summary,
+			Body:             iss.Body,
+			Reporter:         ic.users[iss.Reporter],
+			Assignee:         ic.users[iss.Assignee],
+			Priority:         iss.Priority,
+			Labels:           iss.Labels,
+			Components:       iss.Components,
+			FixVersions:      iss.FixVersions,
+			AffectsVersions:  iss.AffectsVersions,
+			OriginalEstimate: iss.OriginalEstimate,
+			CustomFields:     iss.CustomFields,
+			EpicField:        viper.GetString("epic.link"),
+		}
+		if iss.IsEpic() {
+			cr.EpicField = viper.GetString("epic.name")
+			if projectType != jira.ProjectTypeNextGen {
+				cr.Name = iss.Name
+				if cr.Name == "" {
+					cr.Name = iss.Summary
+				}
+			}
+		}
+		if iss.IsSubtask() {
+			cr.SubtaskField = iss.IssueType()
+		}
+		cr.ForProjectType(projectType)
+		cr.ForInstallationType(installation)
+		if cErr == nil {
+			cr.WithCustomFields(configured)
+		}
+
+		resp, err := api.ProxyCreate(ic.client, &cr)
+		if err != nil {
+			ic.fail(iss, "%s", cmdutil.NormalizeJiraError(err.Error()))
+			continue
+		}
+		iss.Key = resp.Key
+		created++
+	}
+
+	return created
+}
+
+// link creates the links between the issues once all the issues are created.
+// It returns the failures of both creating the issues and linking them.
+func (ic *importCmd) link(issues []*importer.Issue) error {
+	for _, iss := range issues {
+		if iss.Key == "" {
+			continue
+		}
+		for _, l := range iss.IssueLinks() {
+			if l.Issue == "" {
+				ic.fail(iss, "%q link skipped as the linked issue is not created", l.Type)
+				continue
+			}
+			if err := ic.client.LinkIssue(iss.Key, l.Issue, l.Type); err != nil {
+				ic.fail(iss, "unable to link to %s as %q: %s", l.Issue, l.Type, cmdutil.NormalizeJiraError(err.Error()))
+				continue
+			}
+			ic.linked++
+		}
+	}
+
+	if ic.failed.Len() > 0 {
+		return &jira.ErrMultipleFailed{Msg: ic.failed.String()}
+	}
+	return nil
+}
+
+func writeMapping(path string, issues []*importer.Issue) error {
+	format := importer.FormatFromPath(path)
+	if format == "" {
+		format = importer.FormatJSON
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := importer.WriteMapping(f, issues, format); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func printDryRun(issues []*importer.Issue) {
+	var links int
+	for _, iss := range issues {
+		links += len(iss.Links)
+	}
+	fmt.Printf("Dry run: %d issue(s) and %d link(s) would be created in this order\n\n", len(issues), links)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tTYPE\tPARENT\tLINKS\tSUMMARY")
+	for _, iss := range issues {
+		refs := make([]string, 0, len(iss.Links))
+		for _, l := range iss.Links {
+			refs = append(refs, fmt.Sprintf("%s:%s", l.Type, l.Issue))
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			iss.Ref(), iss.IssueType(), orDash(iss.ParentRef()), orDash(strings.Join(refs, ", ")), iss.Summary,
+		)
+	}
+	_ = w.Flush()
+}
+
+func printCreated(issues []*importer.Issue) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tKEY\tTYPE\tSUMMARY")
+	for _, iss := range issues {
+		if iss.Key == "" {
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", iss.Ref(), iss.Key, iss.IssueType(), iss.Summary)
+	}
+	_ = w.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -4,11 +4,17 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/assign"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/attachment"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/branch"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/bulk"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/clone"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/comment"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/create"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/delete"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/edit"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/export"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/history"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/imports"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/link"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/move"
@@ -38,6 +44,8 @@ func NewCmdIssue() *cobra.Command {
 		lc, cc, edit.NewCmdEdit(), move.NewCmdMove(), view.NewCmdView(), assign.NewCmdAssign(),
 		link.NewCmdLink(), unlink.NewCmdUnlink(), comment.NewCmdComment(), clone.NewCmdClone(),
 		delete.NewCmdDelete(), watch.NewCmdWatch(), worklog.NewCmdWorklog(),
+		attachment.NewCmdAttachment(), history.NewCmdHistory(), bulk.NewCmdBulk(), branch.NewCmdBranch(),
+		imports.NewCmdImport(), export.NewCmdExport(),
 	)
 
 	list.SetFlags(lc)
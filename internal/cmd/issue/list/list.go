@@ -3,16 +3,20 @@ package list
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cache"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
 
 const (
@@ -38,6 +42,12 @@ $ jira issue list --paginate 20
 # Get 50 items starting from 10
 $ jira issue list --paginate 10:50
 
+# Fetch up to 500 items by following the pagination
+$ jira issue list --limit 500
+
+# Export all issues matching the query in CSV format
+$ jira issue list --all --csv > issues.csv
+
 # Search for issues containing specific text
 $ jira issue list "Feature Request"
 
@@ -56,6 +66,12 @@ $ jira issue list --plain --delimeter "|"
 # List issues as raw JSON data
 $ jira issue list --raw
 
+# Format issues using a Go template
+$ jira issue list --template '{{.Key}} {{.Fields.Summary}} ({{humanDate .Fields.Created}})'
+
+# Print selected fields of the issues as JSON, custom fields can be selected by their name or ID
+$ jira issue list --json key,summary,status.name,customfield_10016
+
 # List issues of type "Epic" in status "Done"
 $ jira issue list -tEpic -sDone
 
@@ -63,7 +79,10 @@ $ jira issue list -tEpic -sDone
 $ jira issue list -s~Open -ax
 
 # List issues from all projects
-$ jira issue list -q"project IS NOT EMPTY"`
+$ jira issue list -q"project IS NOT EMPTY"
+
+# Search issues in the local cache populated by 'jira sync'
+$ jira issue list --offline -s"In Progress" -a"Person A"`
 )
 
 // NewCmdList is a list command.
@@ -98,7 +117,9 @@ func loadList(cmd *cobra.Command, args []string) {
 	err = cmd.Flags().Set("parent", cmdutil.GetJiraIssueKey(project, pk))
 	cmdutil.ExitIfError(err)
 
-	if len(args) > 0 {
+	offline := isOffline(cmd)
+
+	if len(args) > 0 && !offline {
 		searchQuery := fmt.Sprintf(`text ~ %q`, strings.Join(args, " "))
 
 		jqlFlag, err := cmd.Flags().GetString("jql")
@@ -109,16 +130,34 @@ func loadList(cmd *cobra.Command, args []string) {
 		cmdutil.ExitIfError(cmd.Flags().Set("jql", searchQuery))
 	}
 
+	q, err := query.NewIssue(project, cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	from, limit, stream := getLimit(cmd, q.Params())
+	client := api.DefaultClient(debug)
+
+	raw, err := cmd.Flags().GetBool("raw")
+	cmdutil.ExitIfError(err)
+
+	display := getDisplayFormat(cmd, numComments)
+	output := cmdcommon.GetOutput(cmd.Flags())
+
+	// Large result sets are rendered page by page in non-interactive modes
+	// so that we don't have to hold all issues in memory.
+	if stream && !raw && !offline && (output.Enabled() || display.Plain || display.CSV || tui.IsDumbTerminal() || tui.IsNotTTY()) {
+		streamList(api.ProxySearchIterator(client, q.Get(), from, limit), project, display, output)
+		return
+	}
+
 	issues, err := func() ([]*jira.Issue, error) {
+		if offline {
+			return searchOffline(project, server, q.Params(), strings.Join(args, " "), from, limit)
+		}
+
 		s := cmdutil.Info("Fetching issues...")
 		defer s.Stop()
 
-		q, err := query.NewIssue(project, cmd.Flags())
-		if err != nil {
-			return nil, err
-		}
-
-		resp, err := api.ProxySearch(api.DefaultClient(debug), q.Get(), q.Params().From, q.Params().Limit)
+		resp, err := api.ProxySearch(client, q.Get(), from, limit)
 		if err != nil {
 			return nil, err
 		}
@@ -133,14 +172,92 @@ func loadList(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	raw, err := cmd.Flags().GetBool("raw")
-	cmdutil.ExitIfError(err)
+	if output.Enabled() {
+		cmdutil.ExitIfError(view.RenderOutput(os.Stdout, output, issues))
+		return
+	}
 
 	if raw {
 		outputRawJSON(issues)
 		return
 	}
 
+	v := view.IssueList{
+		Project: project,
+		Server:  server,
+		Data:    issues,
+		Refresh: func() {
+			loadList(cmd, args)
+		},
+		Display: display,
+		Offline: offline,
+		Client:  client,
+	}
+
+	cmdutil.ExitIfError(v.Render())
+}
+
+// isOffline tells if the issues should be searched in the local cache.
+func isOffline(cmd *cobra.Command) bool {
+	if cmd.Flags().Lookup("offline") == nil {
+		return false
+	}
+
+	offline, err := cmd.Flags().GetBool("offline")
+	cmdutil.ExitIfError(err)
+
+	return offline
+}
+
+func searchOffline(project, server string, params *query.IssueParams, text string, from, limit uint) ([]*jira.Issue, error) {
+	store, err := cmdcommon.OpenIssueStore(project, server)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := cache.NewIssueFilter(params, text).Apply(store.Issues())
+	if err != nil {
+		return nil, err
+	}
+
+	if from >= uint(len(issues)) {
+		return nil, nil
+	}
+	issues = issues[from:]
+	if limit > 0 && limit < uint(len(issues)) {
+		issues = issues[:limit]
+	}
+	return issues, nil
+}
+
+// getLimit returns the search window. The --limit and --all flags take
+// precedence over the limit passed via --paginate flag.
+func getLimit(cmd *cobra.Command, params *query.IssueParams) (uint, uint, bool) {
+	if cmd.Flags().Lookup("limit") == nil {
+		return params.From, params.Limit, false
+	}
+
+	all, err := cmd.Flags().GetBool("all")
+	cmdutil.ExitIfError(err)
+
+	if all {
+		return params.From, 0, true
+	}
+
+	if cmd.Flags().Changed("limit") {
+		limit, err := cmd.Flags().GetUint("limit")
+		cmdutil.ExitIfError(err)
+
+		if limit == 0 {
+			cmdutil.Failed("The --limit must be greater than 0, use --all to fetch all issues")
+		}
+		return params.From, limit, true
+	}
+
+	return params.From, params.Limit, false
+}
+
+func getDisplayFormat(cmd *cobra.Command, numComments uint) view.DisplayFormat {
 	plain, err := cmd.Flags().GetBool("plain")
 	cmdutil.ExitIfError(err)
 
@@ -170,33 +287,65 @@ func loadList(cmd *cobra.Command, args []string) {
 		comments = max(numComments, 1)
 	}
 
-	v := view.IssueList{
-		Project: project,
-		Server:  server,
-		Data:    issues,
-		Refresh: func() {
-			loadList(cmd, args)
-		},
-		Display: view.DisplayFormat{
-			Plain:        plain,
-			Delimiter:    delimiter,
-			CSV:          csv,
-			NoHeaders:    noHeaders,
-			NoTruncate:   noTruncate,
-			FixedColumns: fixedColumns,
-			Comments:     comments,
-			Columns: func() []string {
-				if columns != "" {
-					return strings.Split(columns, ",")
-				}
-				return []string{}
-			}(),
-			TableStyle: cmdutil.GetTUIStyleConfig(),
-			Timezone:   viper.GetString("timezone"),
-		},
+	customFields, _ := cmdcommon.GetConfiguredCustomFields()
+
+	return view.DisplayFormat{
+		Plain:        plain,
+		Delimiter:    delimiter,
+		CSV:          csv,
+		NoHeaders:    noHeaders,
+		NoTruncate:   noTruncate,
+		FixedColumns: fixedColumns,
+		Comments:     comments,
+		Columns: func() []string {
+			if columns != "" {
+				return strings.Split(columns, ",")
+			}
+			return []string{}
+		}(),
+		TableStyle:   cmdutil.GetTUIStyleConfig(),
+		Timezone:     viper.GetString("timezone"),
+		CustomFields: customFields,
 	}
+}
 
-	cmdutil.ExitIfError(v.Render())
+func streamList(it *jira.SearchIterator, project string, display view.DisplayFormat, output view.Output) {
+	var (
+		total int
+		all   []*jira.Issue // Issues are collected to print the JSON output as a single list.
+	)
+
+	for it.Next() {
+		issues := it.Issues()
+		total += len(issues)
+
+		switch {
+		case output.Template != "":
+			cmdutil.ExitIfError(view.RenderOutput(os.Stdout, output, issues))
+		case output.Enabled():
+			all = append(all, issues...)
+		default:
+			v := view.IssueList{
+				Project: project,
+				Data:    issues,
+				Display: display,
+			}
+			cmdutil.ExitIfError(v.Render())
+
+			// Headers are only printed for the first page.
+			display.NoHeaders = true
+		}
+	}
+	cmdutil.ExitIfError(it.Err())
+
+	if total == 0 {
+		fmt.Println()
+		cmdutil.Failed("No result found for given query in project %q", project)
+		return
+	}
+	if len(all) > 0 {
+		cmdutil.ExitIfError(view.RenderOutput(os.Stdout, output, all))
+	}
 }
 
 func outputRawJSON(issues []*jira.Issue) {
@@ -239,6 +388,11 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().String("order-by", "created", "Field to order the list with")
 	cmd.Flags().Bool("reverse", false, "Reverse the display order (default \"DESC\")")
 	cmd.Flags().String("paginate", "0:100", "Paginate the result. Max 100 at a time, format: <from>:<limit> where <from> is optional")
+	if cmd.HasParent() && cmd.Parent().Name() == "issue" {
+		cmd.Flags().Uint("limit", 0, "Maximum number of issues to fetch. Follows the pagination to fetch more than 100 issues")
+		cmd.Flags().Bool("all", false, "Fetch all issues matching the query")
+		cmd.Flags().Bool("offline", false, "Search the issues in the local cache populated by 'jira sync'")
+	}
 	cmd.Flags().Bool("plain", false, "Display output in plain mode")
 	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
 	cmd.Flags().Bool("no-truncate", false, "Show all available columns in plain mode. Works only with --plain")
@@ -246,10 +400,12 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().Uint("comments", 1, "Show N comments when viewing the issue")
 	cmd.Flags().Bool("raw", false, "Print raw JSON output")
 	cmd.Flags().Bool("csv", false, "Print output in CSV format")
+	cmdcommon.SetOutputFlags(cmd)
 
 	if cmd.HasParent() && cmd.Parent().Name() != "sprint" {
 		cmd.Flags().String("columns", "", "Comma separated list of columns to display in the plain mode.\n"+
-			fmt.Sprintf("Accepts: %s", strings.Join(view.ValidIssueColumns(), ", ")))
+			fmt.Sprintf("Accepts: %s", strings.Join(view.ValidIssueColumns(), ", "))+
+			"\nand the name of any custom field configured in the config file")
 		cmd.Flags().Uint("fixed-columns", 1, "Number of fixed columns in the interactive mode")
 	}
 }
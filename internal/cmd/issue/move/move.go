@@ -21,7 +21,10 @@ const (
 	examples = `$ jira issue move ISSUE-1 "In Progress"
 $ jira issue move ISSUE-1 Done
 $ jira issue move ISSUE-1 "In Progress" --custom story-points=5
-$ jira issue move ISSUE-1 Done --assignee jane --custom environment=production`
+$ jira issue move ISSUE-1 Done --assignee jane --custom environment=production
+
+# Move the issue of the current git branch, eg: feature/ISSUE-1-fix-login
+$ jira issue move "In Progress"`
 
 	optionCancel = "Cancel"
 )
@@ -35,7 +38,7 @@ func NewCmdMove() *cobra.Command {
 		Example: examples,
 		Aliases: []string{"transition", "mv"},
 		Annotations: map[string]string{
-			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1
+			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1. Defaults to the issue key in the current git branch name
 STATE		State you want to transition the issue to`,
 		},
 		Run: move,
@@ -155,14 +158,11 @@ type moveParams struct {
 }
 
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *moveParams {
-	var key, state string
+	var state string
 
-	nargs := len(args)
-	if nargs >= 1 {
-		key = cmdutil.GetJiraIssueKey(project, args[0])
-	}
-	if nargs >= 2 {
-		state = args[1]
+	key, rest := cmdutil.GetJiraIssueKeyFromArgs(project, args, 2)
+	if len(rest) >= 1 {
+		state = rest[0]
 	}
 
 	comment, err := flags.GetString("comment")
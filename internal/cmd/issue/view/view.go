@@ -1,12 +1,14 @@
 package view
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	tuiView "github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -21,12 +23,19 @@ const (
 $ jira issue view ISSUE-1 --comments 5
 
 # Get the raw JSON data
-$ jira issue view ISSUE-1 --raw`
+$ jira issue view ISSUE-1 --raw
+
+# View the issue from the local cache populated by 'jira sync'
+$ jira issue view ISSUE-1 --offline
+
+# View the issue of the current git branch, eg: feature/ISSUE-1-fix-login
+$ jira issue view`
 
 	flagRaw      = "raw"
 	flagDebug    = "debug"
 	flagComments = "comments"
 	flagPlain    = "plain"
+	flagOffline  = "offline"
 
 	configProject = "project.key"
 	configServer  = "server"
@@ -37,21 +46,21 @@ $ jira issue view ISSUE-1 --raw`
 // NewCmdView is a view command.
 func NewCmdView() *cobra.Command {
 	cmd := cobra.Command{
-		Use:     "view ISSUE-KEY",
+		Use:     "view [ISSUE-KEY]",
 		Short:   "View displays contents of an issue",
 		Long:    helpText,
 		Example: examples,
 		Aliases: []string{"show"},
 		Annotations: map[string]string{
-			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1. Defaults to the issue key in the current git branch name",
 		},
-		Args: cobra.MinimumNArgs(1),
-		Run:  view,
+		Run: view,
 	}
 
 	cmd.Flags().Uint(flagComments, 1, "Show N comments")
 	cmd.Flags().Bool(flagPlain, false, "Display output in plain mode")
 	cmd.Flags().Bool(flagRaw, false, "Print raw Jira API response")
+	cmd.Flags().Bool(flagOffline, false, "View the issue from the local cache populated by 'jira sync'")
 
 	return &cmd
 }
@@ -60,18 +69,35 @@ func view(cmd *cobra.Command, args []string) {
 	raw, err := cmd.Flags().GetBool(flagRaw)
 	cmdutil.ExitIfError(err)
 
+	key, _ := cmdutil.GetJiraIssueKeyFromArgs(viper.GetString(configProject), args, 1)
+	if key == "" {
+		cmdutil.Failed("`ISSUE-KEY` is required when the current git branch doesn't contain an issue key")
+	}
+
 	if raw {
-		viewRaw(cmd, args)
+		viewRaw(cmd, key)
 		return
 	}
-	viewPretty(cmd, args)
+	viewPretty(cmd, key)
 }
 
-func viewRaw(cmd *cobra.Command, args []string) {
+func viewRaw(cmd *cobra.Command, key string) {
 	debug, err := cmd.Flags().GetBool(flagDebug)
 	cmdutil.ExitIfError(err)
 
-	key := cmdutil.GetJiraIssueKey(viper.GetString(configProject), args[0])
+	offline, err := cmd.Flags().GetBool(flagOffline)
+	cmdutil.ExitIfError(err)
+
+	if offline {
+		iss, err := getOfflineIssue(key)
+		cmdutil.ExitIfError(err)
+
+		out, err := json.MarshalIndent(iss, "", "  ")
+		cmdutil.ExitIfError(err)
+
+		fmt.Println(string(out))
+		return
+	}
 
 	apiResp, err := func() (string, error) {
 		s := cmdutil.Info(messageFetchingData)
@@ -85,7 +111,7 @@ func viewRaw(cmd *cobra.Command, args []string) {
 	fmt.Println(apiResp)
 }
 
-func viewPretty(cmd *cobra.Command, args []string) {
+func viewPretty(cmd *cobra.Command, key string) {
 	debug, err := cmd.Flags().GetBool(flagDebug)
 	cmdutil.ExitIfError(err)
 
@@ -98,8 +124,14 @@ func viewPretty(cmd *cobra.Command, args []string) {
 		comments = max(numComments, 1)
 	}
 
-	key := cmdutil.GetJiraIssueKey(viper.GetString(configProject), args[0])
+	offline, err := cmd.Flags().GetBool(flagOffline)
+	cmdutil.ExitIfError(err)
+
 	iss, err := func() (*jira.Issue, error) {
+		if offline {
+			return getOfflineIssue(key)
+		}
+
 		s := cmdutil.Info(messageFetchingData)
 		defer s.Stop()
 
@@ -119,3 +151,18 @@ func viewPretty(cmd *cobra.Command, args []string) {
 	}
 	cmdutil.ExitIfError(v.Render())
 }
+
+func getOfflineIssue(key string) (*jira.Issue, error) {
+	project := viper.GetString(configProject)
+
+	store, err := cmdcommon.OpenIssueStore(project, viper.GetString(configServer))
+	if err != nil {
+		return nil, err
+	}
+
+	iss, ok := store.Get(key)
+	if !ok {
+		return nil, fmt.Errorf("issue %s is not available in the local cache of project %q, run 'jira sync' to update it", key, project)
+	}
+	return iss, nil
+}
@@ -0,0 +1,61 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Delete removes a worklog from an issue.`
+	examples = `$ jira issue worklog delete ISSUE-1 20000
+
+# Set the remaining estimate of the issue after removing the worklog
+$ jira issue worklog delete ISSUE-1 20000 --new-estimate 4h`
+)
+
+// NewCmdWorklogDelete is a worklog delete command.
+func NewCmdWorklogDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete ISSUE-KEY WORKLOG_ID",
+		Short:   "Delete a worklog from an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"WORKLOG_ID\tID of the worklog, see 'jira issue worklog list'",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  del,
+	}
+
+	cmd.Flags().String("new-estimate", "", "the new estimate for the backlog to be completed by")
+
+	return &cmd
+}
+
+func del(cmd *cobra.Command, args []string) {
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	id := args[1]
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	newEstimate, err := cmd.Flags().GetString("new-estimate")
+	cmdutil.ExitIfError(err)
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Removing worklog %q", id))
+		defer s.Stop()
+
+		return api.DefaultClient(debug).DeleteIssueWorklog(key, id, newEstimate)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Worklog %q removed from issue %q", id, key)
+}
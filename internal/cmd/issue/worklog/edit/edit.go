@@ -0,0 +1,221 @@
+package edit
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const (
+	helpText = `Edit updates an existing worklog of an issue.`
+	examples = `$ jira issue worklog edit ISSUE-1 20000
+
+# Pass the new time spent and use --no-input to skip prompt
+$ jira issue worklog edit ISSUE-1 20000 "3h 30m" --no-input
+
+# Update the comment and start date of a worklog
+$ jira issue worklog edit ISSUE-1 20000 --comment "Code review" --started "2022-01-01 09:30:00" --no-input`
+)
+
+// NewCmdWorklogEdit is a worklog edit command.
+func NewCmdWorklogEdit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "edit ISSUE-KEY WORKLOG_ID [TIME_SPENT]",
+		Short:   "Edit a worklog of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"update"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"WORKLOG_ID\tID of the worklog, see 'jira issue worklog list'\n" +
+				"TIME_SPENT\tTime to log as days (d), hours (h), or minutes (m), separated by space eg: 2d 1h 30m",
+		},
+		Args: cobra.RangeArgs(2, 3),
+		Run:  edit,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("started", "", "The datetime on which the worklog effort was started, eg: 2022-01-01 09:30:00")
+	cmd.Flags().String("timezone", "UTC", "The timezone to use for the started date in IANA timezone format, eg: Europe/Berlin")
+	cmd.Flags().String("comment", "", "Comment about the worklog")
+	cmd.Flags().String("new-estimate", "", "the new estimate for the backlog to be completed by")
+	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+
+	return &cmd
+}
+
+func edit(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(args, cmd.Flags())
+	client := api.DefaultClient(params.debug)
+	ec := editCmd{
+		client: client,
+		params: params,
+	}
+
+	if !params.noInput {
+		worklog, err := func() (*jira.Worklog, error) {
+			s := cmdutil.Info("Fetching worklog...")
+			defer s.Stop()
+
+			return client.GetIssueWorklog(params.issueKey, params.worklogID)
+		}()
+		cmdutil.ExitIfError(err)
+
+		ans := struct{ TimeSpent, Comment string }{}
+		err = survey.Ask(ec.getQuestions(worklog), &ans)
+		cmdutil.ExitIfError(err)
+
+		if ans.TimeSpent != worklog.TimeSpent {
+			params.timeSpent = ans.TimeSpent
+		}
+		if ans.Comment != md.FromJiraMD(worklog.Comment) {
+			params.comment = ans.Comment
+		}
+
+		answer := struct{ Action string }{}
+		err = survey.Ask([]*survey.Question{getNextAction()}, &answer)
+		cmdutil.ExitIfError(err)
+
+		if answer.Action == cmdcommon.ActionCancel {
+			cmdutil.Failed("Action aborted")
+		}
+	}
+
+	if params.timeSpent == "" && params.started == "" && params.comment == "" && params.newEstimate == "" {
+		cmdutil.Failed("Nothing to update")
+	}
+
+	err := func() error {
+		s := cmdutil.Info("Updating worklog")
+		defer s.Stop()
+
+		return client.UpdateIssueWorklog(
+			params.issueKey, params.worklogID, params.started, params.timeSpent, params.comment, params.newEstimate,
+		)
+	}()
+	cmdutil.ExitIfError(err)
+
+	server := viper.GetString("server")
+
+	cmdutil.Success("Worklog %q of issue %q updated", params.worklogID, params.issueKey)
+	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, params.issueKey))
+}
+
+type editParams struct {
+	issueKey    string
+	worklogID   string
+	started     string
+	timeSpent   string
+	comment     string
+	newEstimate string
+	noInput     bool
+	debug       bool
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *editParams {
+	var timeSpent string
+
+	issueKey := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	worklogID := args[1]
+
+	if len(args) >= 3 {
+		timeSpent = args[2]
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	started, err := flags.GetString("started")
+	cmdutil.ExitIfError(err)
+
+	timezone, err := flags.GetString("timezone")
+	cmdutil.ExitIfError(err)
+
+	startedWithTZ, err := cmdutil.DateStringToJiraFormatInLocation(started, timezone)
+	cmdutil.ExitIfError(err)
+
+	comment, err := flags.GetString("comment")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	newEstimate, err := flags.GetString("new-estimate")
+	cmdutil.ExitIfError(err)
+
+	return &editParams{
+		issueKey:    issueKey,
+		worklogID:   worklogID,
+		started:     startedWithTZ,
+		timeSpent:   timeSpent,
+		comment:     comment,
+		newEstimate: newEstimate,
+		noInput:     noInput,
+		debug:       debug,
+	}
+}
+
+type editCmd struct {
+	client *jira.Client
+	params *editParams
+}
+
+func (ec *editCmd) getQuestions(worklog *jira.Worklog) []*survey.Question {
+	timeSpent := ec.params.timeSpent
+	if timeSpent == "" {
+		timeSpent = worklog.TimeSpent
+	}
+	comment := ec.params.comment
+	if comment == "" {
+		comment = md.FromJiraMD(worklog.Comment)
+	}
+
+	return []*survey.Question{
+		{
+			Name: "timeSpent",
+			Prompt: &survey.Input{
+				Message: "Time spent",
+				Default: timeSpent,
+				Help:    "Time to log as days (d), hours (h), or minutes (m), separated by space eg: 2d 1h 30m",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "comment",
+			Prompt: &surveyext.JiraEditor{
+				Editor: &survey.Editor{
+					Message:       "Comment body",
+					Default:       comment,
+					HideDefault:   true,
+					AppendDefault: true,
+				},
+				BlankAllowed: true,
+			},
+		},
+	}
+}
+
+func getNextAction() *survey.Question {
+	return &survey.Question{
+		Name: "action",
+		Prompt: &survey.Select{
+			Message: "What's next?",
+			Options: []string{
+				cmdcommon.ActionSubmit,
+				cmdcommon.ActionCancel,
+			},
+		},
+		Validate: survey.Required,
+	}
+}
@@ -0,0 +1,278 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Report aggregates time logged by a user within a date range.
+
+The time spent is grouped by issue, day and user by default. Use --group-by to change the grouping.`
+	examples = `# Time you logged today
+$ jira issue worklog report
+
+# Time you logged in a given date range
+$ jira issue worklog report --from 2022-01-01 --to 2022-01-31
+
+# Time logged by another user, grouped per day
+$ jira issue worklog report --from 2022-01-01 --to 2022-01-07 --user "Jon Doe" --group-by day
+
+# Export the report as CSV
+$ jira issue worklog report --from 2022-01-01 --to 2022-01-31 --csv > timesheet.csv`
+)
+
+// NewCmdWorklogReport is a worklog report command.
+func NewCmdWorklogReport() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "report",
+		Short:   "Report time logged within a date range",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"timesheet"},
+		Args:    cobra.NoArgs,
+		Run:     report,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("from", "", "Start date of the report, eg: 2022-01-01 (defaults to today)")
+	cmd.Flags().String("to", "", "End date of the report, eg: 2022-01-31 (defaults to the start date)")
+	cmd.Flags().StringP("user", "u", "", "User to generate report for (username, email or display name). Defaults to you")
+	cmd.Flags().String("timezone", "UTC", "The timezone to use for the dates in IANA timezone format, eg: Europe/Berlin")
+	cmd.Flags().StringP("jql", "q", "", "Additional JQL to filter the issues, eg: project = TEST")
+	cmd.Flags().String("group-by", "issue,day,user", "Comma separated list of fields to group the report by.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join([]string{view.WorklogGroupByIssue, view.WorklogGroupByDay, view.WorklogGroupByUser}, ", ")))
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+	cmd.Flags().String("delimiter", "\t", "Custom delimeter for columns in plain mode. Works only with --plain")
+	cmd.Flags().Bool("csv", false, "Print output in CSV format")
+
+	return &cmd
+}
+
+func report(cmd *cobra.Command, _ []string) {
+	params := parseFlags(cmd)
+	client := api.DefaultClient(params.debug)
+
+	user, err := resolveUser(client, params.user)
+	cmdutil.ExitIfError(err)
+
+	entries, err := func() ([]view.WorklogReportEntry, error) {
+		s := cmdutil.Info("Fetching worklogs...")
+		defer s.Stop()
+
+		return fetchEntries(client, params, user)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(entries) == 0 {
+		cmdutil.Failed("No worklogs found between %s and %s", params.fromDate, params.toDate)
+		return
+	}
+
+	v := view.WorklogReport{
+		Data:    entries,
+		GroupBy: strings.Split(params.groupBy, ","),
+		Display: view.DisplayFormat{
+			Plain:     params.plain,
+			Delimiter: params.delimiter,
+			CSV:       params.csv,
+			NoHeaders: params.noHeaders,
+			Timezone:  params.timezone,
+		},
+	}
+
+	cmdutil.ExitIfError(v.Render())
+}
+
+type reportParams struct {
+	from      time.Time
+	to        time.Time
+	fromDate  string
+	toDate    string
+	user      string
+	timezone  string
+	jql       string
+	groupBy   string
+	plain     bool
+	noHeaders bool
+	delimiter string
+	csv       bool
+	debug     bool
+}
+
+func parseFlags(cmd *cobra.Command) *reportParams {
+	flags := cmd.Flags()
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	timezone, err := flags.GetString("timezone")
+	cmdutil.ExitIfError(err)
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		cmdutil.ExitIfError(cmdutil.ErrInvlalidTimezone)
+	}
+
+	fromFlag, err := flags.GetString("from")
+	cmdutil.ExitIfError(err)
+	if fromFlag == "" {
+		fromFlag = time.Now().In(loc).Format(cmdutil.DateLayout)
+	}
+
+	toFlag, err := flags.GetString("to")
+	cmdutil.ExitIfError(err)
+	if toFlag == "" {
+		toFlag = fromFlag
+	}
+
+	from, err := parseDate(fromFlag, timezone)
+	cmdutil.ExitIfError(err)
+
+	to, err := parseDate(toFlag, timezone)
+	cmdutil.ExitIfError(err)
+
+	if to.Before(from) {
+		cmdutil.Failed("The end date cannot be before the start date")
+	}
+
+	user, err := flags.GetString("user")
+	cmdutil.ExitIfError(err)
+
+	jql, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	groupBy, err := flags.GetString("group-by")
+	cmdutil.ExitIfError(err)
+
+	plain, err := flags.GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := flags.GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	delimiter, err := flags.GetString("delimiter")
+	cmdutil.ExitIfError(err)
+
+	csv, err := flags.GetBool("csv")
+	cmdutil.ExitIfError(err)
+
+	return &reportParams{
+		from:     from,
+		to:       to.AddDate(0, 0, 1), // End date is inclusive.
+		fromDate: from.Format(cmdutil.DateLayout),
+		toDate:   to.Format(cmdutil.DateLayout),
+		user:     user,
+		timezone: timezone,
+		jql:      jql,
+		groupBy:  groupBy,
+
+		plain:     plain,
+		noHeaders: noHeaders,
+		delimiter: delimiter,
+		csv:       csv,
+		debug:     debug,
+	}
+}
+
+// parseDate parses the date using the same formats accepted by the `worklog add` command
+// and truncates it to the start of the day in the given timezone.
+func parseDate(value, timezone string) (time.Time, error) {
+	dt, err := cmdutil.DateStringToJiraFormatInLocation(value, timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(jira.RFC3339MilliLayout, dt)
+	if err != nil {
+		return time.Time{}, err
+	}
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location()), nil
+}
+
+func resolveUser(client *jira.Client, user string) (*jira.User, error) {
+	if user == "" {
+		me, err := client.Me()
+		if err != nil {
+			return nil, err
+		}
+		return &jira.User{AccountID: me.AccountID, Name: me.Login, DisplayName: me.Name}, nil
+	}
+
+	users, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
+		Query:   user,
+		Project: viper.GetString("project.key"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("unable to find associated user for %s", user)
+	}
+	return users[0], nil
+}
+
+func fetchEntries(client *jira.Client, params *reportParams, user *jira.User) ([]view.WorklogReportEntry, error) {
+	q := fmt.Sprintf(
+		`worklogAuthor = %q AND worklogDate >= %q AND worklogDate <= %q`,
+		cmdcommon.GetUserKeyForConfiguredInstallation(user), params.fromDate, params.toDate,
+	)
+	if params.jql != "" {
+		q = fmt.Sprintf("%s AND (%s)", q, params.jql)
+	}
+	q += " ORDER BY key ASC"
+
+	if params.debug {
+		fmt.Printf("JQL: %s\n", q)
+	}
+
+	res, err := api.ProxySearch(client, q, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []view.WorklogReportEntry
+
+	for _, iss := range res.Issues {
+		worklogs, err := client.GetAllIssueWorklogs(iss.Key)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range worklogs {
+			if !isSameUser(&w.Author, user) {
+				continue
+			}
+			started, err := time.Parse(jira.RFC3339, w.Started)
+			if err != nil || started.Before(params.from) || !started.Before(params.to) {
+				continue
+			}
+			entries = append(entries, view.WorklogReportEntry{
+				Key:     iss.Key,
+				Summary: iss.Fields.Summary,
+				Worklog: w,
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+func isSameUser(a, b *jira.User) bool {
+	if a.AccountID != "" || b.AccountID != "" {
+		return a.AccountID == b.AccountID
+	}
+	return a.Name == b.Name
+}
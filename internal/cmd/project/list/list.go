@@ -1,23 +1,39 @@
 package list
 
 import (
+	"os"
+
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
+const examples = `$ jira project list
+
+# Format each item using a Go template
+$ jira project list --template '{{.Key}} {{.Name}}'
+
+# Print selected fields as JSON
+$ jira project list --json key,name,lead.displayName`
+
 // NewCmdList is a list command.
 func NewCmdList() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "list",
 		Short:   "List lists Jira projects",
 		Long:    "List lists Jira projects that a user has access to.",
+		Example: examples,
 		Aliases: []string{"lists", "ls"},
 		Run:     List,
 	}
+
+	cmdcommon.SetOutputFlags(&cmd)
+
+	return &cmd
 }
 
 // List displays a list view.
@@ -42,6 +58,11 @@ func List(cmd *cobra.Command, _ []string) {
 		return
 	}
 
+	if output := cmdcommon.GetOutput(cmd.Flags()); output.Enabled() {
+		cmdutil.ExitIfError(view.RenderOutput(os.Stdout, output, projects))
+		return
+	}
+
 	v := view.NewProject(projects)
 
 	cmdutil.ExitIfError(v.Render())
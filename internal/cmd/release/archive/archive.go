@@ -0,0 +1,62 @@
+package archive
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Archive archives a release. Archived releases are hidden from the version pickers in Jira.`
+	examples = `$ jira release archive v1.0.0`
+)
+
+// NewCmdArchive is an archive command.
+func NewCmdArchive() *cobra.Command {
+	return &cobra.Command{
+		Use:     "archive VERSION",
+		Short:   "Archive a release",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "VERSION\tName or ID of the release, eg: v1.0.0",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  archive,
+	}
+}
+
+func archive(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	release, err := func() (*jira.ProjectVersion, error) {
+		s := cmdutil.Info("Archiving release...")
+		defer s.Stop()
+
+		release, err := cmdcommon.FindRelease(client, project, args[0])
+		if err != nil {
+			return nil, err
+		}
+		if release.Archived {
+			return nil, fmt.Errorf("release %q is already archived", release.Name)
+		}
+
+		archived := true
+
+		return client.UpdateRelease(release.ID, &jira.ReleaseRequest{Archived: &archived})
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Release %q has been archived", release.Name))
+}
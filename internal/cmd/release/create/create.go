@@ -0,0 +1,107 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Create creates a release, ie: a version, in the project.`
+	examples = `$ jira release create v1.0.0
+
+# Create a release with a description and dates
+$ jira release create v1.0.0 --description "First stable release" --start-date 2022-01-03 --release-date 2022-01-31`
+)
+
+// NewCmdCreate is a create command.
+func NewCmdCreate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "create NAME",
+		Short:   "Create a release in the project",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"new"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the release, eg: v1.0.0",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  create,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringP("description", "d", "", "Description of the release")
+	cmd.Flags().String("start-date", "", "Start date of the release, eg: 2022-01-03")
+	cmd.Flags().String("release-date", "", "Release date of the release, eg: 2022-01-31")
+	cmd.Flags().Bool("released", false, "Mark the release as released")
+
+	return &cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseArgsAndFlags(cmd.Flags(), args, project)
+	client := api.DefaultClient(params.debug)
+
+	release, err := func() (*jira.ProjectVersion, error) {
+		s := cmdutil.Info("Creating release...")
+		defer s.Stop()
+
+		return client.CreateRelease(&params.req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Release %q created with ID %s", release.Name, release.ID))
+}
+
+type createParams struct {
+	req   jira.ReleaseRequest
+	debug bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *createParams {
+	description, err := flags.GetString("description")
+	cmdutil.ExitIfError(err)
+
+	startDate, err := flags.GetString("start-date")
+	cmdutil.ExitIfError(err)
+
+	startDate, err = cmdcommon.ReleaseDate(startDate)
+	cmdutil.ExitIfError(err)
+
+	releaseDate, err := flags.GetString("release-date")
+	cmdutil.ExitIfError(err)
+
+	releaseDate, err = cmdcommon.ReleaseDate(releaseDate)
+	cmdutil.ExitIfError(err)
+
+	released, err := flags.GetBool("released")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	req := jira.ReleaseRequest{
+		Name:        args[0],
+		Description: description,
+		Project:     project,
+		StartDate:   startDate,
+		ReleaseDate: releaseDate,
+	}
+	if released {
+		req.Released = &released
+	}
+
+	return &createParams{
+		req:   req,
+		debug: debug,
+	}
+}
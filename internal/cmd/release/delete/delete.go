@@ -0,0 +1,102 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+)
+
+const (
+	helpText = `Delete deletes a release.
+
+The release is removed from the fix and affects versions of the issues. Use --merge-into
+to move the issues to another release instead, ie: to merge the releases.`
+
+	examples = `$ jira release delete v1.0.0
+
+# Merge v1.0.0 into v1.1.0
+$ jira release delete v1.0.0 --merge-into v1.1.0`
+)
+
+// NewCmdDelete is a delete command.
+func NewCmdDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete VERSION",
+		Short:   "Delete a release",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": "VERSION\tName or ID of the release, eg: v1.0.0",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  del,
+	}
+
+	cmd.Flags().String("merge-into", "", "Name or ID of the release to move the issues to")
+
+	return &cmd
+}
+
+func del(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	name, err := func() (string, error) {
+		s := cmdutil.Info("Removing release...")
+		defer s.Stop()
+
+		release, err := cmdcommon.FindRelease(client, project, params.version)
+		if err != nil {
+			return "", err
+		}
+
+		var moveTo string
+		if params.mergeInto != "" {
+			target, err := cmdcommon.FindRelease(client, project, params.mergeInto)
+			if err != nil {
+				return "", err
+			}
+			if target.ID == release.ID {
+				return "", fmt.Errorf("cannot merge release %q into itself", release.Name)
+			}
+			moveTo = target.ID
+		}
+
+		return release.Name, client.DeleteRelease(release.ID, moveTo)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if params.mergeInto != "" {
+		cmdutil.Success(fmt.Sprintf("Release %q merged into %q", name, params.mergeInto))
+		return
+	}
+	cmdutil.Success(fmt.Sprintf("Release %q removed successfully", name))
+}
+
+type deleteParams struct {
+	version   string
+	mergeInto string
+	debug     bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *deleteParams {
+	mergeInto, err := flags.GetString("merge-into")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &deleteParams{
+		version:   args[0],
+		mergeInto: mergeInto,
+		debug:     debug,
+	}
+}
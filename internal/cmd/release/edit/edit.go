@@ -0,0 +1,111 @@
+package edit
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Edit updates the name, description or dates of a release. Only the passed values are updated.`
+	examples = `$ jira release edit v1.0.0 --name v1.0.1
+
+# Postpone the release
+$ jira release edit v1.0.0 --release-date 2022-02-15 --description "Postponed due to holidays"`
+)
+
+// NewCmdEdit is an edit command.
+func NewCmdEdit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "edit VERSION",
+		Short:   "Edit a release",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"update"},
+		Annotations: map[string]string{
+			"help:args": "VERSION\tName or ID of the release, eg: v1.0.0",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  edit,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("name", "", "New name of the release")
+	cmd.Flags().StringP("description", "d", "", "Description of the release")
+	cmd.Flags().String("start-date", "", "Start date of the release, eg: 2022-01-03")
+	cmd.Flags().String("release-date", "", "Release date of the release, eg: 2022-01-31")
+
+	return &cmd
+}
+
+func edit(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	if params.req == (jira.ReleaseRequest{}) {
+		cmdutil.Failed("Nothing to update, pass at least one of --name, --description, --start-date or --release-date")
+	}
+
+	release, err := func() (*jira.ProjectVersion, error) {
+		s := cmdutil.Info("Updating release...")
+		defer s.Stop()
+
+		release, err := cmdcommon.FindRelease(client, project, params.version)
+		if err != nil {
+			return nil, err
+		}
+		return client.UpdateRelease(release.ID, &params.req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Release %q has been updated", release.Name))
+}
+
+type editParams struct {
+	version string
+	req     jira.ReleaseRequest
+	debug   bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *editParams {
+	name, err := flags.GetString("name")
+	cmdutil.ExitIfError(err)
+
+	description, err := flags.GetString("description")
+	cmdutil.ExitIfError(err)
+
+	startDate, err := flags.GetString("start-date")
+	cmdutil.ExitIfError(err)
+
+	startDate, err = cmdcommon.ReleaseDate(startDate)
+	cmdutil.ExitIfError(err)
+
+	releaseDate, err := flags.GetString("release-date")
+	cmdutil.ExitIfError(err)
+
+	releaseDate, err = cmdcommon.ReleaseDate(releaseDate)
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &editParams{
+		version: args[0],
+		req: jira.ReleaseRequest{
+			Name:        name,
+			Description: description,
+			StartDate:   startDate,
+			ReleaseDate: releaseDate,
+		},
+		debug: debug,
+	}
+}
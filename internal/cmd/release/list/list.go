@@ -1,24 +1,40 @@
 package list
 
 import (
+	"os"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
+const examples = `$ jira release list
+
+# Format each item using a Go template
+$ jira release list --template '{{.Name}} {{if .Released}}(released {{.ReleaseDate}}){{end}}'
+
+# Print selected fields as JSON
+$ jira release list --json id,name,released,releaseDate`
+
 // NewCmdList is a list command.
 func NewCmdList() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "list",
 		Short:   "List lists Jira projects versions",
 		Long:    "List lists Jira projects versions that a user has access to.",
+		Example: examples,
 		Aliases: []string{"lists", "ls"},
 		Run:     List,
 	}
+
+	cmdcommon.SetOutputFlags(&cmd)
+
+	return &cmd
 }
 
 // List displays a list view.
@@ -44,6 +60,11 @@ func List(cmd *cobra.Command, _ []string) {
 		return
 	}
 
+	if output := cmdcommon.GetOutput(cmd.Flags()); output.Enabled() {
+		cmdutil.ExitIfError(view.RenderOutput(os.Stdout, output, releases))
+		return
+	}
+
 	v := view.NewRelease(releases)
 
 	cmdutil.ExitIfError(v.Render())
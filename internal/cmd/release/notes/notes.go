@@ -0,0 +1,131 @@
+package notes
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Notes generates release notes from the issues in a release, ie: the issues with the
+release as their fix version.
+
+The issues are grouped by issue type, component or label and rendered as Markdown or HTML.
+You can also use your own Go template with the --template flag. The template receives
+the release Name, Description, StartDate, ReleaseDate and Released fields along with the
+grouped issues in .Groups and all issues in .Issues. Each group has a Name and Issues,
+and each issue has Key, Summary, Type, Status, Assignee, URL, Components and Labels fields.
+A join function is available to join lists, eg: {{ join .Labels ", " }}.`
+
+	examples = `$ jira release notes v1.0.0
+
+# Group issues by component and render as HTML
+$ jira release notes v1.0.0 --group-by component --format html > notes.html
+
+# Render using a custom template
+$ jira release notes v1.0.0 --template notes.tmpl`
+)
+
+// NewCmdNotes is a notes command.
+func NewCmdNotes() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "notes VERSION",
+		Short:   "Generate release notes for a release",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"changelog"},
+		Annotations: map[string]string{
+			"help:args": "VERSION\tName or ID of the release, eg: v1.0.0",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  notes,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringP("group-by", "g", view.GroupByType, "Group issues by: type, component or label")
+	cmd.Flags().StringP("format", "f", view.ReleaseNotesMarkdown, "Output format: markdown or html")
+	cmd.Flags().StringP("template", "t", "", "Path to a Go template file to render the notes with")
+
+	return &cmd
+}
+
+func notes(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	release, issues, err := func() (*jira.ProjectVersion, []*jira.Issue, error) {
+		s := cmdutil.Info("Collecting issues in the release...")
+		defer s.Stop()
+
+		release, err := cmdcommon.FindRelease(client, project, params.version)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		issues, err := cmdcommon.ReleaseIssues(client, project, release, "key ASC")
+		if err != nil {
+			return nil, nil, err
+		}
+		return release, issues, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	v := view.ReleaseNotes{
+		Server:   viper.GetString("server"),
+		Release:  release,
+		Issues:   issues,
+		GroupBy:  params.groupBy,
+		Format:   params.format,
+		Template: params.template,
+	}
+	cmdutil.ExitIfError(v.Render(os.Stdout))
+}
+
+type notesParams struct {
+	version  string
+	groupBy  string
+	format   string
+	template string
+	debug    bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *notesParams {
+	groupBy, err := flags.GetString("group-by")
+	cmdutil.ExitIfError(err)
+
+	format, err := flags.GetString("format")
+	cmdutil.ExitIfError(err)
+
+	templateFile, err := flags.GetString("template")
+	cmdutil.ExitIfError(err)
+
+	var tmpl string
+	if templateFile != "" {
+		b, err := os.ReadFile(templateFile)
+		if err != nil {
+			cmdutil.Failed("Unable to read template file: %s", err)
+		}
+		tmpl = string(b)
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &notesParams{
+		version:  args[0],
+		groupBy:  groupBy,
+		format:   format,
+		template: tmpl,
+		debug:    debug,
+	}
+}
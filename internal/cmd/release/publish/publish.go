@@ -0,0 +1,98 @@
+package publish
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Release marks a release as released. The release date is set to today unless the --date flag is used.`
+	examples = `$ jira release release v1.0.0
+
+# Release with a specific release date
+$ jira release release v1.0.0 --date 2022-01-31`
+)
+
+// NewCmdPublish is a release command.
+func NewCmdPublish() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "release VERSION",
+		Short:   "Mark a release as released",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"publish", "ship"},
+		Annotations: map[string]string{
+			"help:args": "VERSION\tName or ID of the release, eg: v1.0.0",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  publish,
+	}
+
+	cmd.Flags().String("date", "", "Release date, eg: 2022-01-31 (defaults to today)")
+
+	return &cmd
+}
+
+func publish(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	release, err := func() (*jira.ProjectVersion, error) {
+		s := cmdutil.Info("Releasing...")
+		defer s.Stop()
+
+		release, err := cmdcommon.FindRelease(client, project, params.version)
+		if err != nil {
+			return nil, err
+		}
+		if release.Released {
+			return nil, fmt.Errorf("release %q is already released", release.Name)
+		}
+
+		released := true
+
+		return client.UpdateRelease(release.ID, &jira.ReleaseRequest{
+			ReleaseDate: params.date,
+			Released:    &released,
+		})
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Release %q has been released", release.Name))
+}
+
+type publishParams struct {
+	version string
+	date    string
+	debug   bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *publishParams {
+	date, err := flags.GetString("date")
+	cmdutil.ExitIfError(err)
+
+	if date == "" {
+		date = time.Now().Format(cmdutil.DateLayout)
+	}
+	date, err = cmdcommon.ReleaseDate(date)
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &publishParams{
+		version: args[0],
+		date:    date,
+		debug:   debug,
+	}
+}
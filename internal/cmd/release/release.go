@@ -3,7 +3,14 @@ package release
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/archive"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/create"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/edit"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/notes"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/publish"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/view"
 )
 
 const helpText = `Release manages Jira Project versions. See available commands below.`
@@ -19,7 +26,16 @@ func NewCmdRelease() *cobra.Command {
 		RunE:        releases,
 	}
 
-	cmd.AddCommand(list.NewCmdList())
+	cmd.AddCommand(
+		list.NewCmdList(),
+		view.NewCmdView(),
+		create.NewCmdCreate(),
+		edit.NewCmdEdit(),
+		publish.NewCmdPublish(),
+		archive.NewCmdArchive(),
+		delete.NewCmdDelete(),
+		notes.NewCmdNotes(),
+	)
 
 	return &cmd
 }
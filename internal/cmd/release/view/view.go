@@ -0,0 +1,91 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	tuiView "github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `View displays the details of a release along with the issues in it,
+ie: the issues with the release as their fix version, and a breakdown of the issues per status.`
+
+	examples = `$ jira release view v1.0.0
+
+# Get raw JSON output
+$ jira release view v1.0.0 --raw`
+)
+
+// NewCmdView is a view command.
+func NewCmdView() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "view VERSION",
+		Short:   "View a release and the issues in it",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"show"},
+		Annotations: map[string]string{
+			"help:args": "VERSION\tName or ID of the release, eg: v1.0.0",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  view,
+	}
+
+	cmd.Flags().Bool("raw", false, "Print raw JSON output")
+
+	return &cmd
+}
+
+func view(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	raw, err := cmd.Flags().GetBool("raw")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	release, issues, err := func() (*jira.ProjectVersion, []*jira.Issue, error) {
+		s := cmdutil.Info("Fetching release details...")
+		defer s.Stop()
+
+		release, err := cmdcommon.FindRelease(client, project, args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		issues, err := cmdcommon.ReleaseIssues(client, project, release, "status ASC, key ASC")
+		if err != nil {
+			return nil, nil, err
+		}
+		return release, issues, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if raw {
+		data, err := json.MarshalIndent(struct {
+			Release *jira.ProjectVersion `json:"release"`
+			Issues  []*jira.Issue        `json:"issues"`
+		}{release, issues}, "", "  ")
+		cmdutil.ExitIfError(err)
+
+		fmt.Println(string(data))
+		return
+	}
+
+	v := tuiView.ReleaseDetail{
+		Release: release,
+		Issues:  issues,
+	}
+	cmdutil.ExitIfError(v.Render())
+}
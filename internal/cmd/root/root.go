@@ -1,6 +1,7 @@
 package root
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"slices"
@@ -8,9 +9,14 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cache"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/board"
+	cacheCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/cache"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/completion"
+	contextCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/context"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/epic"
+	gitCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/git"
 	initCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/init"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/man"
@@ -20,6 +26,8 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/release"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/serverinfo"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint"
+	syncCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/sync"
+	templateCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/template"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/version"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
@@ -37,6 +45,9 @@ const (
 var (
 	config string
 	debug  bool
+
+	// localConfig holds the settings from the repository-local config, if any.
+	localConfig map[string]interface{}
 )
 
 func init() {
@@ -66,6 +77,11 @@ func init() {
 		if err := viper.ReadInConfig(); err == nil && debug {
 			fmt.Printf("Using config file: %s\n", viper.ConfigFileUsed())
 		}
+
+		// 4. Repository-local config is merged over the config file
+		if err := applyLocalConfig(); err != nil {
+			cmdutil.Failed("Error: %s", err)
+		}
 	})
 }
 
@@ -79,8 +95,25 @@ func NewCmdRoot() *cobra.Command {
 			return cmd.Help()
 		},
 		PersistentPreRun: func(cmd *cobra.Command, _ []string) {
-			subCmd := cmd.Name()
-			if !cmdRequireToken(subCmd) {
+			setClientContext(cmd)
+			setClientCache()
+
+			// Context commands manage the contexts themselves, so they shouldn't
+			// fail if the active context is missing or the token isn't set.
+			if isContextCmd(cmd) {
+				return
+			}
+			if name := viper.GetString(jiraConfig.ContextKey); name != "" {
+				if err := jiraConfig.ApplyContext(name); err != nil {
+					cmdutil.Failed("Error: %s", err)
+				}
+				// Repository-local config takes precedence over the context.
+				if localConfig != nil {
+					_ = viper.MergeConfigMap(localConfig)
+				}
+			}
+
+			if !cmdRequireToken(cmd.Name(), cmd.CommandPath()) {
 				return
 			}
 
@@ -112,13 +145,22 @@ func NewCmdRoot() *cobra.Command {
 			configHome, jiraConfig.Dir, jiraConfig.FileName,
 		),
 	)
+	cmd.PersistentFlags().String(
+		"context", "",
+		"Named context in the config to use (defaults to the active context, can be overridden with JIRA_CONTEXT env var)",
+	)
 	cmd.PersistentFlags().BoolVar(&debug, "debug", false, "Turn on debug output")
+	cmd.PersistentFlags().String("debug-file", "", "Write debug output to the file instead of stdout, implies --debug")
+	cmd.PersistentFlags().Duration("timeout", 0, "Maximum time to wait for each request to complete, eg: 30s, 2m")
 
 	cmd.SetHelpFunc(helpFunc)
 
 	_ = viper.BindPFlag("config", cmd.PersistentFlags().Lookup("config"))
 	_ = viper.BindPFlag("project.key", cmd.PersistentFlags().Lookup("project"))
+	_ = viper.BindPFlag(jiraConfig.ContextKey, cmd.PersistentFlags().Lookup("context"))
 	_ = viper.BindPFlag("debug", cmd.PersistentFlags().Lookup("debug"))
+	_ = viper.BindPFlag("debug_file", cmd.PersistentFlags().Lookup("debug-file"))
+	_ = viper.BindPFlag("timeout", cmd.PersistentFlags().Lookup("timeout"))
 
 	addChildCommands(&cmd)
 
@@ -131,6 +173,10 @@ func addChildCommands(cmd *cobra.Command) {
 		issue.NewCmdIssue(),
 		epic.NewCmdEpic(),
 		sprint.NewCmdSprint(),
+		syncCmd.NewCmdSync(),
+		cacheCmd.NewCmdCache(),
+		gitCmd.NewCmdGit(),
+		templateCmd.NewCmdTemplate(),
 		board.NewCmdBoard(),
 		project.NewCmdProject(),
 		open.NewCmdOpen(),
@@ -139,11 +185,66 @@ func addChildCommands(cmd *cobra.Command) {
 		completion.NewCmdCompletion(),
 		version.NewCmdVersion(),
 		release.NewCmdRelease(),
+		contextCmd.NewCmdContext(),
 		man.NewCmdMan(),
 	)
 }
 
-func cmdRequireToken(cmd string) bool {
+func isContextCmd(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		if c.Name() == "context" && c.HasParent() && !c.Parent().HasParent() {
+			return true
+		}
+	}
+	return false
+}
+
+// setClientContext attaches the command context to the api client so that
+// the requests are cancelled on interrupt. The configured timeout is applied
+// to each request by the client instead, see api.Client.
+func setClientContext(cmd *cobra.Command) {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	api.SetContext(ctx)
+}
+
+// applyLocalConfig merges the repository-local config, ie: the first .jira.yml
+// found walking up from the current directory, over the global config.
+func applyLocalConfig() error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+	file, ok := jiraConfig.FindLocalConfig(wd)
+	if !ok {
+		return nil
+	}
+
+	settings, err := jiraConfig.ReadLocalConfig(file)
+	if err != nil {
+		return err
+	}
+	if debug {
+		fmt.Printf("Using local config file: %s\n", file)
+	}
+
+	localConfig = settings
+	return viper.MergeConfigMap(settings)
+}
+
+// setClientCache attaches the on-disk response cache to the api client.
+// The cache is only used if it is enabled in the config.
+func setClientCache() {
+	dir, err := cache.HTTPCachePath()
+	if err != nil {
+		return
+	}
+	api.SetCache(cache.NewHTTPCache(dir))
+}
+
+func cmdRequireToken(name, path string) bool {
 	allowList := []string{
 		"init",
 		"help",
@@ -152,7 +253,13 @@ func cmdRequireToken(cmd string) bool {
 		"completion",
 		"man",
 	}
-	return !slices.Contains(allowList, cmd)
+	// Subcommands are matched by their full path so that other
+	// commands with the same name still require the token.
+	pathAllowList := []string{
+		"jira cache clear",
+		"jira git hook install",
+	}
+	return !slices.Contains(allowList, name) && !slices.Contains(pathAllowList, path)
 }
 
 func checkForJiraToken(server string, login string) {
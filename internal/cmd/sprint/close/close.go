@@ -2,23 +2,44 @@ package close
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/spf13/cobra"
 )
 
 const (
-	helpText = `Close sprint.`
-	examples = `$ jira sprint close SPRINT_ID`
+	helpText = `Close sprint.
+
+Incomplete issues, ie: issues that are not in the done status category, are moved to the
+backlog by Jira. Use --move-to to move them to the next future sprint in the board or to
+a sprint of your choice instead. The issues are moved before the sprint is closed, so the
+sprint stays open if any of them can't be moved.`
+
+	examples = `$ jira sprint close SPRINT_ID
+
+# Move incomplete issues to the next future sprint in the board
+$ jira sprint close SPRINT_ID --move-to next
+
+# Move incomplete issues to the given sprint
+$ jira sprint close SPRINT_ID --move-to 124`
+
+	moveToNext    = "next"
+	moveToBacklog = "backlog"
+
+	// Max number of issues that can be moved at once.
+	maxMoveIssues = 50
 )
 
 // NewCmdClose is an add command.
 func NewCmdClose() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "close SPRINT_ID",
 		Short:   "Close sprint",
 		Long:    helpText,
@@ -29,6 +50,10 @@ func NewCmdClose() *cobra.Command {
 		},
 		Run: closeSprint,
 	}
+
+	cmd.Flags().String("move-to", "", "Move incomplete issues to 'next' future sprint, 'backlog' or the given sprint ID")
+
+	return &cmd
 }
 
 func closeSprint(cmd *cobra.Command, args []string) {
@@ -48,20 +73,120 @@ func closeSprint(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	err := func() error {
+	sprintID, err := strconv.Atoi(params.sprintID)
+	cmdutil.ExitIfError(err)
+
+	var (
+		target     string
+		incomplete []string
+	)
+
+	// Jira moves the incomplete issues to the backlog itself when the sprint is closed.
+	if params.moveTo != "" && !strings.EqualFold(params.moveTo, moveToBacklog) {
+		target, incomplete, err = func() (string, []string, error) {
+			s := cmdutil.Info("Fetching incomplete issues...")
+			defer s.Stop()
+
+			target, err := moveTarget(client, sprintID, params.moveTo)
+			if err != nil {
+				return "", nil, err
+			}
+			issues, err := incompleteIssues(client, sprintID)
+			return target, issues, err
+		}()
+		cmdutil.ExitIfError(err)
+	}
+
+	// The issues are moved before closing the sprint, otherwise Jira would have already
+	// moved them to the backlog and the command couldn't be re-run if the move fails.
+	if len(incomplete) > 0 {
+		err = func() error {
+			s := cmdutil.Info(fmt.Sprintf("Moving %d incomplete issues...", len(incomplete)))
+			defer s.Stop()
+
+			return moveIssues(client, target, incomplete)
+		}()
+		cmdutil.ExitIfError(err)
+	}
+
+	err = func() error {
 		s := cmdutil.Info("Closing sprint...\n")
 		defer s.Stop()
 
-		sprintID, err := strconv.Atoi(params.sprintID)
-		if err != nil {
-			return err
-		}
-
 		return client.EndSprint(sprintID)
 	}()
 	cmdutil.ExitIfError(err)
 
-	cmdutil.Success(fmt.Sprintf("Sprint %s has been closed.", params.sprintID))
+	if len(incomplete) == 0 {
+		cmdutil.Success(fmt.Sprintf("Sprint %s has been closed.", params.sprintID))
+		return
+	}
+	cmdutil.Success(fmt.Sprintf("Sprint %s has been closed. %d incomplete issues moved to sprint %s.", params.sprintID, len(incomplete), target))
+}
+
+// moveTarget resolves the ID of the sprint to move the incomplete issues to.
+func moveTarget(client *jira.Client, sprintID int, moveTo string) (string, error) {
+	switch strings.ToLower(moveTo) {
+	case moveToNext:
+		sprint, err := client.GetSprint(sprintID)
+		if err != nil {
+			return "", err
+		}
+		// Sprints are returned in the order they are planned in the board.
+		res, err := client.Sprints(sprint.BoardID, "state="+jira.SprintStateFuture, 0, 1)
+		if err != nil {
+			return "", err
+		}
+		if len(res.Sprints) == 0 {
+			return "", fmt.Errorf("no future sprint found in the board %d", sprint.BoardID)
+		}
+		return strconv.Itoa(res.Sprints[0].ID), nil
+	}
+
+	id, err := strconv.Atoi(moveTo)
+	if err != nil {
+		return "", fmt.Errorf("--move-to should be either 'next', 'backlog' or a sprint ID")
+	}
+	if id == sprintID {
+		return "", fmt.Errorf("cannot move issues to the sprint being closed")
+	}
+	return moveTo, nil
+}
+
+// incompleteIssues returns the keys of the issues that are not done in the sprint.
+func incompleteIssues(client *jira.Client, sprintID int) ([]string, error) {
+	const limit = 100
+
+	var (
+		from uint
+		keys []string
+	)
+
+	for {
+		res, err := client.SprintIssues(sprintID, "statusCategory != Done", from, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, iss := range res.Issues {
+			keys = append(keys, iss.Key)
+		}
+
+		from += uint(len(res.Issues))
+		if len(res.Issues) == 0 || int(from) >= res.Total {
+			break
+		}
+	}
+
+	return keys, nil
+}
+
+func moveIssues(client *jira.Client, target string, issues []string) error {
+	for chunk := range slices.Chunk(issues, maxMoveIssues) {
+		if err := client.SprintIssuesAdd(target, chunk...); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func parseFlags(flags query.FlagParser, args []string) *addParams {
@@ -72,11 +197,15 @@ func parseFlags(flags query.FlagParser, args []string) *addParams {
 		sprintID = args[0]
 	}
 
+	moveTo, err := flags.GetString("move-to")
+	cmdutil.ExitIfError(err)
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
 	return &addParams{
 		sprintID: sprintID,
+		moveTo:   moveTo,
 		debug:    debug,
 	}
 }
@@ -97,5 +226,6 @@ func getQuestions(params *addParams) []*survey.Question {
 
 type addParams struct {
 	sprintID string
+	moveTo   string
 	debug    bool
 }
@@ -0,0 +1,114 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Create creates a future sprint in a board.
+
+The sprint is created in the board set in the config unless the --board flag is used.
+The dates are interpreted in the timezone set in the config, or the local timezone.`
+
+	examples = `$ jira sprint create "Sprint 12"
+
+# Create a sprint with dates and a goal
+$ jira sprint create "Sprint 12" --start 2022-01-03 --end 2022-01-16 --goal "Release the new checkout"
+
+# Create a sprint in another board
+$ jira sprint create "Sprint 12" --board 5`
+)
+
+// NewCmdCreate is a create command.
+func NewCmdCreate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "create NAME",
+		Short:   "Create a sprint in a board",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"new"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the sprint, eg: Sprint 12",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  create,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().Uint("board", 0, "ID of the board to create the sprint in (defaults to the board in the config)")
+	cmdcommon.SetSprintFlags(&cmd)
+
+	return &cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	sprint, err := func() (*jira.Sprint, error) {
+		s := cmdutil.Info("Creating sprint...")
+		defer s.Stop()
+
+		return client.CreateSprint(&params.req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Sprint %q created with ID %d", sprint.Name, sprint.ID))
+}
+
+type createParams struct {
+	req   jira.SprintRequest
+	debug bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *createParams {
+	boardFlag, err := flags.GetUint("board")
+	cmdutil.ExitIfError(err)
+
+	board := int(boardFlag)
+	if board == 0 {
+		board = viper.GetInt("board.id")
+	}
+	if board == 0 {
+		cmdutil.Failed("Board is not set in the config, use --board flag to pass the board ID")
+	}
+
+	start, err := flags.GetString("start")
+	cmdutil.ExitIfError(err)
+
+	start, err = cmdcommon.SprintDate(start)
+	cmdutil.ExitIfError(err)
+
+	end, err := flags.GetString("end")
+	cmdutil.ExitIfError(err)
+
+	end, err = cmdcommon.SprintDate(end)
+	cmdutil.ExitIfError(err)
+
+	goal, err := flags.GetString("goal")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &createParams{
+		req: jira.SprintRequest{
+			Name:      args[0],
+			BoardID:   board,
+			StartDate: start,
+			EndDate:   end,
+			Goal:      goal,
+		},
+		debug: debug,
+	}
+}
@@ -0,0 +1,109 @@
+package edit
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Edit updates the name, goal or dates of a sprint. Only the passed values are updated.`
+
+	examples = `# Rename a sprint
+$ jira sprint edit SPRINT_ID --name "Sprint 12 - Checkout"
+
+# Update goal and extend the sprint
+$ jira sprint edit SPRINT_ID --goal "Release the new checkout" --end 2022-01-20`
+)
+
+// NewCmdEdit is an edit command.
+func NewCmdEdit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "edit SPRINT_ID",
+		Short:   "Edit a sprint",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"update"},
+		Annotations: map[string]string{
+			"help:args": "SPRINT_ID\tID of the sprint you want to edit, eg: 123",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  edit,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("name", "", "New name of the sprint")
+	cmdcommon.SetSprintFlags(&cmd)
+
+	return &cmd
+}
+
+func edit(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	if params.req == (jira.SprintRequest{}) {
+		cmdutil.Failed("Nothing to update, pass at least one of --name, --start, --end or --goal")
+	}
+
+	sprint, err := func() (*jira.Sprint, error) {
+		s := cmdutil.Info("Updating sprint...")
+		defer s.Stop()
+
+		return client.UpdateSprint(params.sprintID, &params.req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Sprint %q has been updated", sprint.Name))
+}
+
+type editParams struct {
+	sprintID int
+	req      jira.SprintRequest
+	debug    bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *editParams {
+	sprintID, err := strconv.Atoi(args[0])
+	cmdutil.ExitIfError(err)
+
+	name, err := flags.GetString("name")
+	cmdutil.ExitIfError(err)
+
+	start, err := flags.GetString("start")
+	cmdutil.ExitIfError(err)
+
+	start, err = cmdcommon.SprintDate(start)
+	cmdutil.ExitIfError(err)
+
+	end, err := flags.GetString("end")
+	cmdutil.ExitIfError(err)
+
+	end, err = cmdcommon.SprintDate(end)
+	cmdutil.ExitIfError(err)
+
+	goal, err := flags.GetString("goal")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &editParams{
+		sprintID: sprintID,
+		req: jira.SprintRequest{
+			Name:      name,
+			StartDate: start,
+			EndDate:   end,
+			Goal:      goal,
+		},
+		debug: debug,
+	}
+}
@@ -2,6 +2,7 @@ package list
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
@@ -43,7 +45,14 @@ $ jira sprint list --table --plain --columns name,start,end
 $ jira sprint list <SPRINT_ID> --plain --columns type,key,summary
 
 # Display sprint issues in a plain table view and show all fields
-$ jira sprint list <SPRINT_ID> --plain --no-truncate`
+$ jira sprint list <SPRINT_ID> --plain --no-truncate
+
+# Format sprints or sprint issues using a Go template
+$ jira sprint list --template '{{.ID}} {{.Name}} ({{date "Jan 02" .StartDate}} - {{date "Jan 02" .EndDate}})'
+$ jira sprint list --current --template '{{.Key}} {{.Fields.Summary}}'
+
+# Print selected fields of sprints as JSON
+$ jira sprint list --json id,name,state`
 )
 
 // NewCmdList is a sprint list command.
@@ -118,6 +127,11 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 		return
 	}
 
+	if output := cmdcommon.GetOutput(flags); output.Enabled() {
+		cmdutil.ExitIfError(view.RenderOutput(os.Stdout, output, issues))
+		return
+	}
+
 	plain, err := flags.GetBool("plain")
 	cmdutil.ExitIfError(err)
 
@@ -139,6 +153,8 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 	columns, err := flags.GetString("columns")
 	cmdutil.ExitIfError(err)
 
+	customFields, _ := cmdcommon.GetConfiguredCustomFields()
+
 	var ft string
 	if sprint != nil {
 		if sprint.Status == jira.SprintStateFuture {
@@ -166,6 +182,7 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 		Server:     server,
 		Data:       issues,
 		FooterText: ft,
+		Client:     client,
 		Refresh: func() {
 			singleSprintView(sprintQuery, flags, boardID, sprintID, project, server, client, nil)
 		},
@@ -182,8 +199,9 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 				}
 				return []string{}
 			}(),
-			TableStyle: cmdutil.GetTUIStyleConfig(),
-			Timezone:   viper.GetString("timezone"),
+			TableStyle:   cmdutil.GetTUIStyleConfig(),
+			Timezone:     viper.GetString("timezone"),
+			CustomFields: customFields,
 		},
 	}
 
@@ -212,6 +230,11 @@ func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, board
 		return
 	}
 
+	if output := cmdcommon.GetOutput(flags); output.Enabled() {
+		cmdutil.ExitIfError(view.RenderOutput(os.Stdout, output, sprints))
+		return
+	}
+
 	plain, err := flags.GetBool("plain")
 	cmdutil.ExitIfError(err)
 
@@ -284,7 +307,8 @@ func setFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool("table", false, "Display sprints in a table view")
 	cmd.Flags().String("columns", "", "Comma separated list of columns to display in the plain mode.\n"+
 		fmt.Sprintf("Accepts (for sprint list): %s", strings.Join(view.ValidSprintColumns(), ", "))+
-		fmt.Sprintf("\nAccepts (for sprint issues): %s", strings.Join(view.ValidIssueColumns(), ", ")))
+		fmt.Sprintf("\nAccepts (for sprint issues): %s", strings.Join(view.ValidIssueColumns(), ", "))+
+		"\nand the name of any custom field configured in the config file")
 	cmd.Flags().Uint("fixed-columns", 1, "Number of fixed columns in the interactive mode")
 	cmd.Flags().Bool("current", false, "List issues in current active sprint")
 	cmd.Flags().Bool("prev", false, "List issues in previous sprint")
@@ -0,0 +1,134 @@
+package start
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Start starts a future sprint.
+
+The sprint starts now unless the --start flag is used. If the end date is not passed,
+the planned end date of the sprint is used, or the sprint ends two weeks after it starts.`
+
+	examples = `$ jira sprint start SPRINT_ID
+
+# Start a sprint with an end date and a goal
+$ jira sprint start SPRINT_ID --end 2022-01-16 --goal "Release the new checkout"`
+)
+
+// NewCmdStart is a start command.
+func NewCmdStart() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "start SPRINT_ID",
+		Short:   "Start a future sprint",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "SPRINT_ID\tID of the sprint you want to start, eg: 123",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  start,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmdcommon.SetSprintFlags(&cmd)
+
+	return &cmd
+}
+
+func start(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	sprint, err := func() (*jira.Sprint, error) {
+		s := cmdutil.Info("Starting sprint...")
+		defer s.Stop()
+
+		sprint, err := client.GetSprint(params.sprintID)
+		if err != nil {
+			return nil, err
+		}
+		if sprint.Status != jira.SprintStateFuture {
+			return nil, fmt.Errorf("sprint %d is already %s", params.sprintID, sprint.Status)
+		}
+
+		req := params.req
+		if req.StartDate == "" {
+			req.StartDate = time.Now().Format(jira.RFC3339MilliLayout)
+		}
+		if req.EndDate == "" {
+			req.EndDate, err = endDate(req.StartDate, sprint.EndDate)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return client.StartSprint(params.sprintID, &req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Sprint %q has been started", sprint.Name))
+}
+
+// endDate returns the planned end date of the sprint if it is after the
+// start date, otherwise the end date of a sprint of default length.
+func endDate(start, planned string) (string, error) {
+	if planned != "" {
+		s, errStart := time.Parse(jira.RFC3339MilliLayout, start)
+		e, errEnd := time.Parse(time.RFC3339, planned)
+		if errStart == nil && errEnd == nil && e.After(s) {
+			return e.Format(jira.RFC3339MilliLayout), nil
+		}
+	}
+	return cmdcommon.SprintEndDate(start)
+}
+
+type startParams struct {
+	sprintID int
+	req      jira.SprintRequest
+	debug    bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *startParams {
+	sprintID, err := strconv.Atoi(args[0])
+	cmdutil.ExitIfError(err)
+
+	startDate, err := flags.GetString("start")
+	cmdutil.ExitIfError(err)
+
+	startDate, err = cmdcommon.SprintDate(startDate)
+	cmdutil.ExitIfError(err)
+
+	end, err := flags.GetString("end")
+	cmdutil.ExitIfError(err)
+
+	end, err = cmdcommon.SprintDate(end)
+	cmdutil.ExitIfError(err)
+
+	goal, err := flags.GetString("goal")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &startParams{
+		sprintID: sprintID,
+		req: jira.SprintRequest{
+			StartDate: startDate,
+			EndDate:   end,
+			Goal:      goal,
+		},
+		debug: debug,
+	}
+}
@@ -0,0 +1,110 @@
+package sync
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cache"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Sync fetches the issues of a project to a local cache.
+
+The first sync fetches all issues in the project. Subsequent syncs only fetch the
+issues that were updated since the last sync. The cached issues can then be searched
+and viewed without reaching the server using the --offline flag of the issue list
+and issue view commands.
+
+Deleted issues and issues moved to other projects are only removed from the cache
+when the cache is rebuilt using the --full flag.`
+
+	examples = `$ jira sync
+
+# Discard the local cache and fetch all issues again
+$ jira sync --full
+
+# Sync issues in another project
+$ jira sync -p PRJ
+
+# Search the synced issues
+$ jira issue list --offline -s"To Do" -yHigh`
+
+	// syncMargin is the number of minutes added to the sync window to account
+	// for the minute precision of JQL dates and the clock drift with the server.
+	syncMargin = 5
+)
+
+// NewCmdSync is a sync command.
+func NewCmdSync() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "sync",
+		Short:   "Sync issues of a project to a local cache",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     sync,
+	}
+
+	cmd.Flags().Bool("full", false, "Discard the local cache and fetch all issues again")
+
+	return &cmd
+}
+
+func sync(cmd *cobra.Command, _ []string) {
+	server := viper.GetString("server")
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	full, err := cmd.Flags().GetBool("full")
+	cmdutil.ExitIfError(err)
+
+	path, err := cache.IssueStorePath(server, project)
+	cmdutil.ExitIfError(err)
+
+	store, err := cache.OpenIssueStore(path)
+	cmdutil.ExitIfError(err)
+
+	if full {
+		store.Reset()
+	}
+
+	startedAt := time.Now()
+
+	issues, err := func() ([]*jira.Issue, error) {
+		s := cmdutil.Info(fmt.Sprintf("Syncing issues in project %q...", project))
+		defer s.Stop()
+
+		resp, err := api.ProxySearch(api.DefaultClient(debug), getQuery(store, project, startedAt), 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Issues, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	store.Put(issues...)
+	cmdutil.ExitIfError(store.Save(startedAt))
+
+	cmdutil.Success("Synced %d issues, %d issues in the local cache of project %q", len(issues), store.Len(), project)
+}
+
+func getQuery(store *cache.IssueStore, project string, now time.Time) string {
+	q := fmt.Sprintf("project=%q", project)
+
+	// JQL dates are interpreted in the timezone of the user, so a relative
+	// period is used instead to fetch the issues updated since the last sync.
+	if store.Synced() {
+		minutes := int(now.Sub(store.SyncedAt).Minutes()) + syncMargin
+		q += fmt.Sprintf(" AND updated >= -%dm", minutes)
+	}
+
+	return q + " ORDER BY updated ASC"
+}
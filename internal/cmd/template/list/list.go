@@ -0,0 +1,36 @@
+package list
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/template"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List lists the available issue templates",
+		Long:    "List lists the issue templates available in the template dirs.",
+		Aliases: []string{"lists", "ls"},
+		Args:    cobra.NoArgs,
+		Run:     List,
+	}
+}
+
+// List displays a list view.
+func List(*cobra.Command, []string) {
+	templates, err := template.List()
+	cmdutil.ExitIfError(err)
+
+	if len(templates) == 0 {
+		cmdutil.Failed("No templates found in %s", strings.Join(template.Dirs(), ", "))
+		return
+	}
+
+	cmdutil.ExitIfError(view.NewTemplateList(templates).Render())
+}
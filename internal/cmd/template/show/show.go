@@ -0,0 +1,60 @@
+package show
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/template"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+)
+
+const examples = `$ jira template show bug
+
+# Print the template file as is
+$ jira template show bug --raw`
+
+// NewCmdShow is a show command.
+func NewCmdShow() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "show NAME",
+		Short:   "Show displays an issue template",
+		Long:    "Show displays the fields, variables and the description of an issue template.",
+		Example: examples,
+		Aliases: []string{"view"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the template, eg: bug",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  show,
+	}
+
+	cmd.Flags().Bool("raw", false, "Print the template file as is")
+
+	return &cmd
+}
+
+func show(cmd *cobra.Command, args []string) {
+	path, ok := template.Find(args[0])
+	if !ok {
+		cmdutil.Failed("Template %q not found, run 'jira template list' to see the available templates", args[0])
+	}
+
+	raw, err := cmd.Flags().GetBool("raw")
+	cmdutil.ExitIfError(err)
+
+	if raw {
+		b, err := os.ReadFile(path)
+		cmdutil.ExitIfError(err)
+
+		fmt.Print(string(b))
+		return
+	}
+
+	tmpl, err := template.Load(path)
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(view.TemplateDetail{Template: tmpl}.Render())
+}
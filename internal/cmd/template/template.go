@@ -0,0 +1,35 @@
+package template
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/template/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/template/show"
+)
+
+const helpText = `Template lists and shows the issue templates. See available commands below.
+
+Issue templates are looked up in the issue.templates dir, if configured, and in the
+templates dir inside the config directory. A template is a markdown file with a YAML
+front matter, or a YAML file, that can set the type, summary, priority, labels,
+components, custom fields and sub-tasks of an issue. Use them with
+'jira issue create --template NAME'.`
+
+// NewCmdTemplate is a template command.
+func NewCmdTemplate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "template",
+		Short:   "Template lists and shows the issue templates",
+		Long:    helpText,
+		Aliases: []string{"templates"},
+		RunE:    templates,
+	}
+
+	cmd.AddCommand(list.NewCmdList(), show.NewCmdShow())
+
+	return &cmd
+}
+
+func templates(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,167 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
+)
+
+const (
+	defaultBulkLimit   = 100
+	defaultBulkWorkers = 5
+	maxBulkWorkers     = 20
+)
+
+// BulkParams holds the parameters shared by the bulk commands.
+type BulkParams struct {
+	JQL     string
+	Limit   uint
+	Workers uint
+	DryRun  bool
+	Debug   bool
+}
+
+// SetBulkFlags sets the flags shared by the bulk commands.
+func SetBulkFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("jql", "q", "", "Apply to the issues matching the JQL within the project")
+	cmd.Flags().Uint("limit", defaultBulkLimit, "Maximum number of issues to apply to when using --jql, 0 applies to all matching issues")
+	cmd.Flags().Uint("workers", defaultBulkWorkers, fmt.Sprintf("Number of issues to process concurrently (max %d)", maxBulkWorkers))
+	cmd.Flags().Bool("dry-run", false, "List the issues that would be affected without applying any change")
+}
+
+// GetBulkParams parses the flags shared by the bulk commands.
+func GetBulkParams(flags query.FlagParser) *BulkParams {
+	jql, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	limit, err := flags.GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	workers, err := flags.GetUint("workers")
+	cmdutil.ExitIfError(err)
+
+	if workers < 1 || workers > maxBulkWorkers {
+		cmdutil.Failed("Error: --workers must be between 1 and %d", maxBulkWorkers)
+	}
+
+	dryRun, err := flags.GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &BulkParams{
+		JQL:     jql,
+		Limit:   limit,
+		Workers: workers,
+		DryRun:  dryRun,
+		Debug:   debug,
+	}
+}
+
+// BulkIssues returns the issues to apply a bulk action to along with the number of matching
+// issues. Issues are either searched using the JQL or, if no JQL is given, built from the issue
+// keys piped through the standard input. Issues built from the keys only have the key set.
+//
+// If more issues than the limit match the JQL, an error is returned unless it is a dry run,
+// in which case the issues within the limit are returned.
+func BulkIssues(client *jira.Client, project string, params *BulkParams) ([]*jira.Issue, int, error) {
+	if params.JQL == "" {
+		if !cmdutil.StdinHasData() {
+			return nil, 0, fmt.Errorf("either pass the issues to apply to with --jql or pipe the issue keys through the standard input")
+		}
+
+		keys, err := cmdutil.ReadIssueKeys(os.Stdin, project)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		issues := make([]*jira.Issue, 0, len(keys))
+		for _, k := range keys {
+			issues = append(issues, &jira.Issue{Key: k})
+		}
+		return issues, len(issues), nil
+	}
+
+	s := cmdutil.Info("Fetching issues matching the query...")
+	defer s.Stop()
+
+	q := jql.WithProject(project, params.JQL)
+
+	// An extra issue is fetched to know if there are more matching issues than the limit.
+	limit := params.Limit
+	if limit > 0 {
+		limit++
+	}
+
+	res, err := api.ProxySearch(client, q, 0, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	if params.Limit == 0 || uint(len(res.Issues)) <= params.Limit {
+		return res.Issues, len(res.Issues), nil
+	}
+
+	total, err := api.ProxySearchCount(client, q)
+	if err != nil {
+		return nil, 0, err
+	}
+	// The count is approximate on Jira cloud, so it is at least the number of issues fetched.
+	total = max(total, len(res.Issues))
+
+	if !params.DryRun {
+		return nil, total, fmt.Errorf(
+			"%d issues match the query, which is more than the limit of %d. "+
+				"Narrow down the query or raise --limit, 0 applies to all matching issues", total, params.Limit,
+		)
+	}
+
+	return res.Issues[:params.Limit], total, nil
+}
+
+// BulkIssueKeys returns the keys of the issues.
+func BulkIssueKeys(issues []*jira.Issue) []string {
+	keys := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		keys = append(keys, iss.Key)
+	}
+	return keys
+}
+
+// PrintBulkDryRun lists the issues the action would be applied to. If more issues than
+// listed match the query, the action wouldn't be applied as the limit is exceeded.
+func PrintBulkDryRun(issues []*jira.Issue, total int, action string) {
+	if total > len(issues) {
+		fmt.Printf(
+			"Dry run: %d issues match the query, which is more than the limit of %d, so %s wouldn't be applied. "+
+				"Raise --limit to apply to all of them, the first %d issue(s) are listed below\n\n",
+			total, len(issues), action, len(issues),
+		)
+	} else {
+		fmt.Printf("Dry run: %s would be applied to %d issue(s)\n\n", action, len(issues))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "KEY\tSTATUS\tASSIGNEE\tSUMMARY")
+	for _, iss := range issues {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			iss.Key, orDash(iss.Fields.Status.Name), orDash(iss.Fields.Assignee.Name), orDash(iss.Fields.Summary),
+		)
+	}
+	_ = w.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
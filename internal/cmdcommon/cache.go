@@ -0,0 +1,25 @@
+package cmdcommon
+
+import (
+	"fmt"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cache"
+)
+
+// OpenIssueStore opens the local issue cache of the project. It fails if
+// the project was never synced as there is nothing to search offline.
+func OpenIssueStore(project, server string) (*cache.IssueStore, error) {
+	path, err := cache.IssueStorePath(server, project)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := cache.OpenIssueStore(path)
+	if err != nil {
+		return nil, err
+	}
+	if !store.Synced() {
+		return nil, fmt.Errorf("no local cache found for project %q, run 'jira sync' to populate it", project)
+	}
+	return store, nil
+}
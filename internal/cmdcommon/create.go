@@ -1,7 +1,9 @@
 package cmdcommon
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/template"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
@@ -68,7 +71,7 @@ And, this field is mandatory when creating a sub-task.`)
 	cmd.Flags().StringArray("affects-version", []string{}, "Release info (affectsVersions)")
 	cmd.Flags().StringP("original-estimate", "e", "", prefix+" Original estimate")
 	cmd.Flags().StringToString("custom", custom, "Set custom fields")
-	cmd.Flags().StringP("template", "T", "", "Path to a file to read body/description from")
+	cmd.Flags().StringP("template", "T", "", "Path to a file, or name of a template, to read body/description from")
 	cmd.Flags().Bool("web", false, "Open in web browser after successful creation")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
 }
@@ -241,6 +244,32 @@ func GetConfiguredCustomFields() ([]jira.IssueTypeField, error) {
 	return configuredFields, nil
 }
 
+// SetCreateDefaults sets the labels and components configured using issue.defaults,
+// eg: in a repository-local config, if they are not passed using the flags.
+func SetCreateDefaults(params *CreateParams) {
+	if len(params.Labels) == 0 {
+		params.Labels = viper.GetStringSlice("issue.defaults.labels")
+	}
+	if len(params.Components) == 0 {
+		params.Components = viper.GetStringSlice("issue.defaults.components")
+	}
+}
+
+// ResolveTemplate resolves the name of a template in the template dirs, eg: "bug"
+// resolves to <issue.templates>/bug.md. Existing paths are returned as is.
+func ResolveTemplate(name string) string {
+	if name == "" || name == "-" {
+		return name
+	}
+	if _, err := os.Stat(name); err == nil {
+		return name
+	}
+	if path, ok := template.Find(name); ok {
+		return path
+	}
+	return name
+}
+
 // ValidateCustomFields validates custom fields.
 // TODO: Fail with error instead of warning in future release.
 func ValidateCustomFields(fields map[string]string, configuredFields []jira.IssueTypeField) {
@@ -355,7 +384,7 @@ func ValidateAndFilterCustomFields(
 			"2. Add the required fields to the issue type's create screen\n" +
 			"3. Or use only the fields listed as available above"
 
-		return nil, fmt.Errorf(errMsg)
+		return nil, errors.New(errMsg)
 	}
 
 	return validFields, nil
@@ -0,0 +1,52 @@
+package cmdcommon
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+)
+
+// SetOutputFlags sets the flags to print a list using a Go template or as JSON with selected fields.
+func SetOutputFlags(cmd *cobra.Command) {
+	cmd.Flags().String("template", "", "Format each item in the list using a Go template, eg: '{{.Key}} {{.Fields.Summary}}'\n"+
+		"Helpers: date, humanDate, text, customField, join, truncate and json")
+	cmd.Flags().String("format", "", "Alias for --template")
+	cmd.Flags().String("json", "", "Print JSON with the comma separated list of fields, eg: key,summary,customfield_10016")
+
+	cmdutil.ExitIfError(cmd.Flags().MarkHidden("format"))
+}
+
+// GetOutput parses the flags to print a list using a Go template or as JSON with selected fields.
+func GetOutput(flags query.FlagParser) view.Output {
+	tmpl, err := flags.GetString("template")
+	cmdutil.ExitIfError(err)
+
+	if tmpl == "" {
+		tmpl, err = flags.GetString("format")
+		cmdutil.ExitIfError(err)
+	}
+
+	fields, err := flags.GetString("json")
+	cmdutil.ExitIfError(err)
+
+	var jsonFields []string
+	for _, f := range strings.Split(fields, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			jsonFields = append(jsonFields, f)
+		}
+	}
+
+	customFields, _ := GetConfiguredCustomFields()
+
+	return view.Output{
+		Template:     tmpl,
+		JSONFields:   jsonFields,
+		CustomFields: customFields,
+		Timezone:     viper.GetString("timezone"),
+	}
+}
@@ -0,0 +1,55 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// FindRelease finds a release, ie: a project version, by its name or ID.
+func FindRelease(client *jira.Client, project, version string) (*jira.ProjectVersion, error) {
+	releases, err := client.Release(project)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range releases {
+		if strings.EqualFold(r.Name, version) {
+			return r, nil
+		}
+	}
+	for _, r := range releases {
+		if r.ID == version {
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("release %q not found in project %q", version, project)
+}
+
+// ReleaseIssues fetches all issues with the release as their fix version
+// sorted in the given order, eg: status ASC, key ASC.
+func ReleaseIssues(client *jira.Client, project string, release *jira.ProjectVersion, orderBy string) ([]*jira.Issue, error) {
+	jql := fmt.Sprintf("project=%q AND fixVersion=%s ORDER BY %s", project, release.ID, orderBy)
+
+	res, err := api.ProxySearch(client, jql, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return res.Issues, nil
+}
+
+// ReleaseDate validates a release date, eg: 2022-01-31. Release dates don't have a time part.
+func ReleaseDate(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if _, err := time.Parse(cmdutil.DateLayout, value); err != nil {
+		return "", fmt.Errorf("date should be in YYYY-MM-DD format, eg: 2022-01-31")
+	}
+	return value, nil
+}
@@ -0,0 +1,40 @@
+package cmdcommon
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// DefaultSprintLength is the length of a sprint if the end date is not provided.
+const DefaultSprintLength = 14 * 24 * time.Hour
+
+// SetSprintFlags sets flags supported by sprint create, start and edit commands.
+func SetSprintFlags(cmd *cobra.Command) {
+	cmd.Flags().String("start", "", "Start date of the sprint, eg: 2022-01-02 or 2022-01-02 10:00:00")
+	cmd.Flags().String("end", "", "End date of the sprint, eg: 2022-01-16 or 2022-01-16 18:00:00")
+	cmd.Flags().String("goal", "", "Goal of the sprint")
+}
+
+// SprintDate converts a date passed to the sprint commands to the jira datetime format.
+// The date is interpreted in the timezone set in the config, or the local timezone.
+func SprintDate(value string) (string, error) {
+	tz := viper.GetString("timezone")
+	if tz == "" {
+		tz = "Local"
+	}
+	return cmdutil.DateStringToJiraFormatInLocation(value, tz)
+}
+
+// SprintEndDate returns the end date of a sprint of default length starting at the given date.
+func SprintEndDate(start string) (string, error) {
+	t, err := time.Parse(jira.RFC3339MilliLayout, start)
+	if err != nil {
+		return "", err
+	}
+	return t.Add(DefaultSprintLength).Format(jira.RFC3339MilliLayout), nil
+}
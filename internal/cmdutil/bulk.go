@@ -0,0 +1,87 @@
+package cmdutil
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// ReadIssueKeys reads issue keys separated by whitespaces or commas from the reader.
+// Duplicate keys are ignored and numeric keys are prefixed with the project key.
+func ReadIssueKeys(r io.Reader, project string) ([]string, error) {
+	var (
+		keys []string
+		seen = make(map[string]struct{})
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+
+	for scanner.Scan() {
+		for _, k := range strings.Split(scanner.Text(), ",") {
+			if k = strings.TrimSpace(k); k == "" {
+				continue
+			}
+			k = GetJiraIssueKey(project, k)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			keys = append(keys, k)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// RunBulk runs fn for each key using a pool of the given number of workers. It returns
+// the number of keys fn succeeded for. Failures are aggregated in the order of the keys
+// into a single jira.ErrMultipleFailed error.
+func RunBulk(keys []string, workers int, fn func(key string) error) (int, error) {
+	workers = max(1, min(workers, len(keys)))
+
+	var (
+		wg   sync.WaitGroup
+		jobs = make(chan int)
+		errs = make([]error, len(keys))
+	)
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				errs[i] = fn(keys[i])
+			}
+		}()
+	}
+	for i := range keys {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var (
+		failed strings.Builder
+		done   int
+	)
+	for i, err := range errs {
+		if err != nil {
+			failed.WriteString(fmt.Sprintf("\n  - %s: %s", keys[i], NormalizeJiraError(err.Error())))
+			continue
+		}
+		done++
+	}
+	if failed.Len() > 0 {
+		return done, &jira.ErrMultipleFailed{Msg: failed.String()}
+	}
+
+	return done, nil
+}
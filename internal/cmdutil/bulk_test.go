@@ -0,0 +1,106 @@
+package cmdutil
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestReadIssueKeys(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		project  string
+		expected []string
+	}{
+		{
+			name:     "it reads keys separated by new lines",
+			input:    "TEST-1\nTEST-2\n\nTEST-3\n",
+			project:  "TEST",
+			expected: []string{"TEST-1", "TEST-2", "TEST-3"},
+		},
+		{
+			name:     "it reads keys separated by spaces and commas",
+			input:    "TEST-1 test-2,TEST-3, 4",
+			project:  "TEST",
+			expected: []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4"},
+		},
+		{
+			name:     "it ignores duplicate keys",
+			input:    "TEST-1\nTEST-2\nTEST-1\n2",
+			project:  "TEST",
+			expected: []string{"TEST-1", "TEST-2"},
+		},
+		{
+			name:     "it returns nothing for empty input",
+			input:    " \n ",
+			project:  "TEST",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys, err := ReadIssueKeys(strings.NewReader(tc.input), tc.project)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, keys)
+		})
+	}
+}
+
+func TestRunBulk(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4", "TEST-5"}
+
+	var (
+		running, peak atomic.Int32
+		called        atomic.Int32
+	)
+	done, err := RunBulk(keys, 2, func(key string) error {
+		called.Add(1)
+
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		if key == "TEST-2" || key == "TEST-4" {
+			return errors.New("Error:\n- Issue does not exist")
+		}
+		return nil
+	})
+
+	assert.Equal(t, 3, done)
+	assert.Equal(t, int32(5), called.Load())
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+
+	var e *jira.ErrMultipleFailed
+	assert.ErrorAs(t, err, &e)
+	assert.Equal(t, "\n  - TEST-2: Issue does not exist\n  - TEST-4: Issue does not exist", e.Msg)
+}
+
+func TestRunBulkWithoutFailures(t *testing.T) {
+	t.Parallel()
+
+	done, err := RunBulk([]string{"TEST-1", "TEST-2"}, 10, func(string) error { return nil })
+	assert.NoError(t, err)
+	assert.Equal(t, 2, done)
+
+	done, err = RunBulk(nil, 5, func(string) error { return nil })
+	assert.NoError(t, err)
+	assert.Equal(t, 0, done)
+}
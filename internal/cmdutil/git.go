@@ -0,0 +1,124 @@
+package cmdutil
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/git"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// DefaultBranchTemplate is the template used to name the issue branches.
+const DefaultBranchTemplate = "feature/{{.Key}}-{{slug .Summary}}"
+
+const maxSlugLength = 50
+
+var (
+	issueKeyPattern = regexp.MustCompile(`^(?i)[A-Z][A-Z0-9_]*-\d+$`)
+	anyIssueKey     = regexp.MustCompile(`(^|[^A-Za-z0-9])([A-Z][A-Z0-9_]*-\d+)`)
+	nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// IsIssueKey checks if the value looks like an issue key, eg: ISSUE-1, or an issue number.
+func IsIssueKey(value string) bool {
+	if issueKeyPattern.MatchString(value) {
+		return true
+	}
+	return value != "" && strings.Trim(value, "0123456789") == ""
+}
+
+// IssueKeyFromBranch extracts the issue key from the branch name, eg: feature/ISSUE-1-fix-login.
+//
+// The issue keys of the given project are preferred and matched case-insensitively.
+// Otherwise, the first upper-cased issue key in the branch name is returned.
+func IssueKeyFromBranch(branch, project string) string {
+	if project != "" {
+		re := regexp.MustCompile(`(?i)(^|[^A-Za-z0-9])(` + regexp.QuoteMeta(project) + `-\d+)`)
+		if m := re.FindStringSubmatch(branch); m != nil {
+			return strings.ToUpper(m[2])
+		}
+	}
+	if m := anyIssueKey.FindStringSubmatch(branch); m != nil {
+		return m[2]
+	}
+	return ""
+}
+
+// GetCurrentIssueKey returns the issue key inferred from the name of the current git branch.
+// An empty key is returned if the current directory is not a git repository.
+func GetCurrentIssueKey(project string) string {
+	branch, err := git.CurrentBranch()
+	if err != nil {
+		return ""
+	}
+	return IssueKeyFromBranch(branch, project)
+}
+
+// GetJiraIssueKeyFromArgs constructs actual issue key from the first positional arg. If the
+// issue key is omitted, ie: there are less than n args and the first one doesn't look like
+// an issue key, it is inferred from the current git branch. The remaining args are returned.
+func GetJiraIssueKeyFromArgs(project string, args []string, n int) (string, []string) {
+	if len(args) < n && (len(args) == 0 || !IsIssueKey(args[0])) {
+		if key := GetCurrentIssueKey(project); key != "" {
+			return key, args
+		}
+	}
+	if len(args) == 0 {
+		return "", args
+	}
+	return GetJiraIssueKey(project, args[0]), args[1:]
+}
+
+// BranchName generates the name of the branch for the issue using the given template.
+//
+// The template has access to the Key, Summary, Type and Status of the issue and the
+// slug, lower and upper functions, eg: feature/{{.Key}}-{{slug .Summary}}.
+func BranchName(tmpl string, issue *jira.Issue) (string, error) {
+	if tmpl == "" {
+		tmpl = DefaultBranchTemplate
+	}
+
+	t, err := template.New("branch").Funcs(template.FuncMap{
+		"slug":  Slugify,
+		"lower": strings.ToLower,
+		"upper": strings.ToUpper,
+	}).Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("invalid branch template: %w", err)
+	}
+
+	data := struct {
+		Key     string
+		Summary string
+		Type    string
+		Status  string
+	}{
+		Key:     issue.Key,
+		Summary: issue.Fields.Summary,
+		Type:    issue.Fields.IssueType.Name,
+		Status:  issue.Fields.Status.Name,
+	}
+
+	var out strings.Builder
+	if err := t.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("invalid branch template: %w", err)
+	}
+	return strings.Trim(out.String(), "-/ "), nil
+}
+
+// Slugify converts the text to a lower-cased, dash-separated slug that
+// is safe to use in a branch name. Long slugs are cut at a word boundary.
+func Slugify(text string) string {
+	slug := strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(text), "-"), "-")
+	if len(slug) <= maxSlugLength {
+		return slug
+	}
+
+	slug = slug[:maxSlugLength]
+	if i := strings.LastIndex(slug, "-"); i > 0 {
+		slug = slug[:i]
+	}
+	return slug
+}
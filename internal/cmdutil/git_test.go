@@ -0,0 +1,106 @@
+package cmdutil
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestIsIssueKey(t *testing.T) {
+	t.Parallel()
+
+	for _, key := range []string{"TEST-1", "test-12", "AB_2-100", "100"} {
+		assert.True(t, IsIssueKey(key), key)
+	}
+	for _, key := range []string{"", "In Progress", "Done", "TEST", "TEST-", "-1", "1-TEST", "My comment"} {
+		assert.False(t, IsIssueKey(key), key)
+	}
+}
+
+func TestIssueKeyFromBranch(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		branch   string
+		project  string
+		expected string
+	}{
+		{branch: "feature/TEST-12-fix-login", project: "TEST", expected: "TEST-12"},
+		{branch: "feature/test-12-fix-login", project: "TEST", expected: "TEST-12"},
+		{branch: "TEST-12", project: "", expected: "TEST-12"},
+		{branch: "bugfix/PROJ-3_TEST-4", project: "TEST", expected: "TEST-4"},
+		{branch: "bugfix/PROJ-3_TEST-4", project: "", expected: "PROJ-3"},
+		{branch: "bugfix/PROJ-3", project: "TEST", expected: "PROJ-3"},
+		{branch: "feature/MYTEST-12", project: "TEST", expected: "MYTEST-12"},
+		{branch: "feature/fix-12", project: "", expected: ""},
+		{branch: "main", project: "TEST", expected: ""},
+		{branch: "", project: "TEST", expected: ""},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, IssueKeyFromBranch(tc.branch, tc.project), tc.branch)
+	}
+}
+
+func TestGetJiraIssueKeyFromArgs(t *testing.T) {
+	// Run outside of a git repository, so the key is never inferred from the branch.
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("GIT_CEILING_DIRECTORIES", filepath.Dir(dir))
+
+	key, rest := GetJiraIssueKeyFromArgs("TEST", []string{"1", "Done"}, 2)
+	assert.Equal(t, "TEST-1", key)
+	assert.Equal(t, []string{"Done"}, rest)
+
+	key, rest = GetJiraIssueKeyFromArgs("TEST", []string{"Done"}, 2)
+	assert.Equal(t, "DONE", key)
+	assert.Empty(t, rest)
+
+	key, rest = GetJiraIssueKeyFromArgs("TEST", nil, 1)
+	assert.Equal(t, "", key)
+	assert.Empty(t, rest)
+}
+
+func TestBranchName(t *testing.T) {
+	t.Parallel()
+
+	iss := &jira.Issue{Key: "TEST-1"}
+	iss.Fields.Summary = "Fix the login page: it crashes on Safari!"
+	iss.Fields.IssueType.Name = "Bug"
+	iss.Fields.Status.Name = "To Do"
+
+	name, err := BranchName("", iss)
+	assert.NoError(t, err)
+	assert.Equal(t, "feature/TEST-1-fix-the-login-page-it-crashes-on-safari", name)
+
+	name, err = BranchName("{{lower .Type}}/{{.Key}}", iss)
+	assert.NoError(t, err)
+	assert.Equal(t, "bug/TEST-1", name)
+
+	iss.Fields.Summary = ""
+	name, err = BranchName("", iss)
+	assert.NoError(t, err)
+	assert.Equal(t, "feature/TEST-1", name)
+
+	_, err = BranchName("{{.Unknown}}", iss)
+	assert.Error(t, err)
+
+	_, err = BranchName("{{.Key", iss)
+	assert.Error(t, err)
+}
+
+func TestSlugify(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "fix-login-page", Slugify("  Fix login page "))
+	assert.Equal(t, "c-api-v2-support", Slugify("C++ API (v2) support"))
+	assert.Equal(t, "", Slugify("!!!"))
+	assert.Equal(
+		t,
+		"a-very-long-summary-that-does-not-fit-in-a-branch",
+		Slugify("A very long summary that does not fit in a branch name at all"),
+	)
+}
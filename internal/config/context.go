@@ -0,0 +1,160 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// ContextKey is the config key that holds the name of the active context.
+	ContextKey = "context"
+	// ContextsKey is the config key that holds the named contexts.
+	ContextsKey = "contexts"
+)
+
+var (
+	// ErrConfigNotFound is returned if the config file doesn't exist.
+	ErrConfigNotFound = fmt.Errorf("missing configuration file, run 'jira init' to configure the tool")
+
+	contextNameRegex = regexp.MustCompile(`^[a-z0-9_-]+$`)
+)
+
+// Context is a named set of settings that are merged over
+// the top-level settings in the config when it is active.
+type Context struct {
+	Name         string
+	Installation string
+	Server       string
+	Login        string
+	Project      string
+	Active       bool
+}
+
+// ApplyContext merges the settings of the given context over the top-level settings
+// in the global config. The credentials are thus resolved for the server and login
+// of the context using the same chain as the top-level config.
+func ApplyContext(name string) error {
+	name = strings.ToLower(name)
+
+	settings, ok := contexts(viper.GetViper())[name]
+	if !ok {
+		return fmt.Errorf("context %q doesn't exist", name)
+	}
+	return viper.MergeConfigMap(settings)
+}
+
+// ListContexts returns the contexts defined in the global config sorted by name.
+func ListContexts() []*Context {
+	active := strings.ToLower(viper.GetString(ContextKey))
+
+	all := contexts(viper.GetViper())
+	list := make([]*Context, 0, len(all))
+
+	for name, settings := range all {
+		c := viper.New()
+		_ = c.MergeConfigMap(settings)
+
+		list = append(list, &Context{
+			Name:         name,
+			Installation: c.GetString("installation"),
+			Server:       c.GetString("server"),
+			Login:        c.GetString("login"),
+			Project:      c.GetString("project.key"),
+			Active:       name == active,
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+// AddContext adds a new context with given settings to the config file.
+func AddContext(file, name string, settings map[string]interface{}) error {
+	name = strings.ToLower(name)
+	if !contextNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid context name %q, only letters, numbers, dashes and underscores are allowed", name)
+	}
+
+	return updateConfig(file, func(v *viper.Viper) error {
+		if _, ok := contexts(v)[name]; ok {
+			return fmt.Errorf("context %q already exists", name)
+		}
+		v.Set(ContextsKey+"."+name, settings)
+		return nil
+	})
+}
+
+// UseContext sets the given context as the active context in the config file.
+func UseContext(file, name string) error {
+	name = strings.ToLower(name)
+
+	return updateConfig(file, func(v *viper.Viper) error {
+		if _, ok := contexts(v)[name]; !ok {
+			return fmt.Errorf("context %q doesn't exist", name)
+		}
+		v.Set(ContextKey, name)
+		return nil
+	})
+}
+
+// ReadContextSettings reads the settings from the given config file, eg: a config
+// generated using `jira init`, so that it can be added as a context.
+func ReadContextSettings(file string) (map[string]interface{}, error) {
+	if !Exists(file) {
+		return nil, fmt.Errorf("config file %q doesn't exist", file)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(file)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	settings := v.AllSettings()
+
+	// Nested contexts are not supported.
+	delete(settings, ContextKey)
+	delete(settings, ContextsKey)
+
+	return settings, nil
+}
+
+func contexts(v *viper.Viper) map[string]map[string]interface{} {
+	out := make(map[string]map[string]interface{})
+
+	for name, settings := range v.GetStringMap(ContextsKey) {
+		s, ok := settings.(map[string]interface{})
+		if !ok {
+			// Context without any settings.
+			s = make(map[string]interface{})
+		}
+		out[strings.ToLower(name)] = s
+	}
+
+	return out
+}
+
+// updateConfig reads the config file in a separate instance so that the values
+// merged from flags, env or the active context are not written back to the file.
+func updateConfig(file string, fn func(*viper.Viper) error) error {
+	if !Exists(file) {
+		return ErrConfigNotFound
+	}
+
+	v := viper.New()
+	v.SetConfigFile(file)
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
+	if err := fn(v); err != nil {
+		return err
+	}
+	return v.WriteConfig()
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfig = `installation: Cloud
+server: https://example.atlassian.net
+login: jon@example.com
+project:
+  key: ABC
+  type: classic
+`
+
+func readTestConfig(t *testing.T, file string) {
+	t.Helper()
+
+	viper.Reset()
+	viper.SetConfigFile(file)
+	assert.NoError(t, viper.ReadInConfig())
+}
+
+func TestContexts(t *testing.T) {
+	file := filepath.Join(t.TempDir(), ".config.yml")
+	assert.NoError(t, os.WriteFile(file, []byte(testConfig), 0o600))
+
+	t.Cleanup(viper.Reset)
+
+	assert.NoError(t, AddContext(file, "OnPrem", map[string]interface{}{
+		"installation": "Local",
+		"server":       "https://jira.example.com",
+		"login":        "jon",
+		"project":      map[string]interface{}{"key": "PRJ"},
+	}))
+	assert.NoError(t, AddContext(file, "cloud", map[string]interface{}{
+		"server": "https://example.atlassian.net",
+	}))
+
+	assert.EqualError(t, AddContext(file, "cloud", nil), `context "cloud" already exists`)
+	assert.Error(t, AddContext(file, "on.prem", nil))
+
+	assert.EqualError(t, UseContext(file, "invalid"), `context "invalid" doesn't exist`)
+	assert.NoError(t, UseContext(file, "onprem"))
+
+	readTestConfig(t, file)
+
+	assert.Equal(t, []*Context{
+		{
+			Name:   "cloud",
+			Server: "https://example.atlassian.net",
+		},
+		{
+			Name:         "onprem",
+			Installation: "Local",
+			Server:       "https://jira.example.com",
+			Login:        "jon",
+			Project:      "PRJ",
+			Active:       true,
+		},
+	}, ListContexts())
+
+	// The top-level settings are not touched.
+	assert.Equal(t, "jon@example.com", viper.GetString("login"))
+
+	assert.NoError(t, ApplyContext(viper.GetString(ContextKey)))
+
+	assert.Equal(t, "Local", viper.GetString("installation"))
+	assert.Equal(t, "https://jira.example.com", viper.GetString("server"))
+	assert.Equal(t, "jon", viper.GetString("login"))
+	assert.Equal(t, "PRJ", viper.GetString("project.key"))
+	assert.Equal(t, "classic", viper.GetString("project.type"))
+
+	assert.EqualError(t, ApplyContext("invalid"), `context "invalid" doesn't exist`)
+}
+
+func TestUpdateConfigWithoutFile(t *testing.T) {
+	assert.ErrorIs(t, UseContext(filepath.Join(t.TempDir(), "invalid.yml"), "onprem"), ErrConfigNotFound)
+}
+
+func TestReadContextSettings(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "onprem.yml")
+	assert.NoError(t, os.WriteFile(file, []byte(testConfig+"context: other\n"), 0o600))
+
+	settings, err := ReadContextSettings(file)
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"installation": "Cloud",
+		"server":       "https://example.atlassian.net",
+		"login":        "jon@example.com",
+		"project": map[string]interface{}{
+			"key":  "ABC",
+			"type": "classic",
+		},
+	}, settings)
+}
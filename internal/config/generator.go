@@ -51,6 +51,7 @@ type issueTypeFieldConf struct {
 	Schema struct {
 		DataType string `yaml:"datatype"`
 		Items    string `yaml:"items,omitempty"`
+		Custom   string `yaml:"custom,omitempty"`
 	}
 }
 
@@ -720,9 +721,11 @@ func (c *JiraCLIConfigGenerator) configureFields() error {
 			Schema: struct {
 				DataType string `yaml:"datatype"`
 				Items    string `yaml:"items,omitempty"`
+				Custom   string `yaml:"custom,omitempty"`
 			}{
 				DataType: field.Schema.DataType,
 				Items:    field.Schema.Items,
+				Custom:   field.Schema.Custom,
 			},
 		})
 	}
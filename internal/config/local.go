@@ -0,0 +1,78 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// LocalFileName is the name of the repository-local config file.
+const LocalFileName = ".jira.yml"
+
+// localKeys are the settings that can be set in the repository-local config. Settings like
+// the server and login are not allowed so that a config checked into a repository can't
+// send the credentials to a different server.
+var localKeys = []string{
+	"project.key",
+	"project.type",
+	"board.id",
+	"board.name",
+	"board.type",
+	"issue.defaults.labels",
+	"issue.defaults.components",
+	"issue.templates",
+}
+
+// FindLocalConfig walks up from the given directory to find the repository-local config file.
+func FindLocalConfig(dir string) (string, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+
+	for {
+		file := filepath.Join(dir, LocalFileName)
+		if info, err := os.Stat(file); err == nil && !info.IsDir() {
+			return file, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// ReadLocalConfig reads the settings from the repository-local config file so that they can
+// be merged over the global config. The templates path is resolved relative to the file.
+func ReadLocalConfig(file string) (map[string]interface{}, error) {
+	v := viper.New()
+	v.SetConfigFile(file)
+	v.SetConfigType(FileType)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", file, err)
+	}
+
+	out := viper.New()
+
+	for _, key := range v.AllKeys() {
+		if !slices.Contains(localKeys, key) {
+			return nil, fmt.Errorf(
+				"invalid config %s: %q can't be set in a repository config, supported keys are: %s",
+				file, key, strings.Join(localKeys, ", "),
+			)
+		}
+		out.Set(key, v.Get(key))
+	}
+
+	if dir := out.GetString("issue.templates"); dir != "" && !filepath.IsAbs(dir) {
+		out.Set("issue.templates", filepath.Join(filepath.Dir(file), dir))
+	}
+
+	return out.AllSettings(), nil
+}
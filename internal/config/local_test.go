@@ -0,0 +1,91 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindLocalConfig(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	nested := filepath.Join(root, "repo", "cmd", "app")
+	assert.NoError(t, os.MkdirAll(nested, 0o755))
+
+	_, ok := FindLocalConfig(nested)
+	assert.False(t, ok)
+
+	file := filepath.Join(root, "repo", LocalFileName)
+	assert.NoError(t, os.WriteFile(file, []byte("project:\n  key: PRJ\n"), 0o600))
+
+	got, ok := FindLocalConfig(nested)
+	assert.True(t, ok)
+	assert.Equal(t, file, got)
+
+	got, ok = FindLocalConfig(filepath.Join(root, "repo"))
+	assert.True(t, ok)
+	assert.Equal(t, file, got)
+
+	// Directories with the same name are skipped.
+	assert.NoError(t, os.MkdirAll(filepath.Join(nested, LocalFileName), 0o755))
+
+	got, ok = FindLocalConfig(nested)
+	assert.True(t, ok)
+	assert.Equal(t, file, got)
+}
+
+func TestReadLocalConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	global := filepath.Join(dir, ".config.yml")
+	assert.NoError(t, os.WriteFile(global, []byte(testConfig+"board:\n  id: 1\n  name: ABC board\n  type: scrum\n"), 0o600))
+
+	local := filepath.Join(dir, LocalFileName)
+	assert.NoError(t, os.WriteFile(local, []byte(`project:
+  key: PRJ
+board:
+  id: 42
+  name: PRJ board
+issue:
+  defaults:
+    labels: [backend, api]
+    components: [API]
+  templates: .jira/templates
+`), 0o600))
+
+	t.Cleanup(viper.Reset)
+	readTestConfig(t, global)
+
+	settings, err := ReadLocalConfig(local)
+	assert.NoError(t, err)
+	assert.NoError(t, viper.MergeConfigMap(settings))
+
+	assert.Equal(t, "PRJ", viper.GetString("project.key"))
+	assert.Equal(t, "classic", viper.GetString("project.type"))
+	assert.Equal(t, 42, viper.GetInt("board.id"))
+	assert.Equal(t, "PRJ board", viper.GetString("board.name"))
+	assert.Equal(t, "scrum", viper.GetString("board.type"))
+	assert.Equal(t, []string{"backend", "api"}, viper.GetStringSlice("issue.defaults.labels"))
+	assert.Equal(t, []string{"API"}, viper.GetStringSlice("issue.defaults.components"))
+	assert.Equal(t, filepath.Join(dir, ".jira", "templates"), viper.GetString("issue.templates"))
+	assert.Equal(t, "https://example.atlassian.net", viper.GetString("server"))
+}
+
+func TestReadLocalConfigWithUnsupportedKeys(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), LocalFileName)
+	assert.NoError(t, os.WriteFile(file, []byte("server: https://evil.example.com\nproject:\n  key: PRJ\n"), 0o600))
+
+	_, err := ReadLocalConfig(file)
+	assert.ErrorContains(t, err, `"server" can't be set in a repository config`)
+
+	assert.NoError(t, os.WriteFile(file, []byte("project: [invalid"), 0o600))
+
+	_, err = ReadLocalConfig(file)
+	assert.Error(t, err)
+}
@@ -0,0 +1,248 @@
+package export
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+)
+
+// Issue is an exported issue with its full details. Descriptions, comments
+// and worklog comments are converted to markdown.
+type Issue struct {
+	Key             string                     `json:"key"`
+	Type            string                     `json:"type"`
+	Status          string                     `json:"status"`
+	Resolution      string                     `json:"resolution,omitempty"`
+	Priority        string                     `json:"priority,omitempty"`
+	Summary         string                     `json:"summary"`
+	Description     string                     `json:"description,omitempty"`
+	Assignee        string                     `json:"assignee,omitempty"`
+	Reporter        string                     `json:"reporter,omitempty"`
+	Parent          string                     `json:"parent,omitempty"`
+	Subtasks        []string                   `json:"subtasks,omitempty"`
+	Labels          []string                   `json:"labels,omitempty"`
+	Components      []string                   `json:"components,omitempty"`
+	FixVersions     []string                   `json:"fixVersions,omitempty"`
+	AffectsVersions []string                   `json:"affectsVersions,omitempty"`
+	Created         string                     `json:"created"`
+	Updated         string                     `json:"updated"`
+	CustomFields    map[string]json.RawMessage `json:"customFields,omitempty"`
+	Comments        []*Comment                 `json:"comments"`
+	Worklogs        []*Worklog                 `json:"worklogs"`
+	Links           []*Link                    `json:"links"`
+	Attachments     []*Attachment              `json:"attachments"`
+	Changelog       []*History                 `json:"changelog"`
+}
+
+// Comment is an exported comment.
+type Comment struct {
+	ID      string `json:"id"`
+	Author  string `json:"author"`
+	Created string `json:"created"`
+	Updated string `json:"updated,omitempty"`
+	Body    string `json:"body"`
+}
+
+// Worklog is an exported worklog.
+type Worklog struct {
+	ID               string `json:"id"`
+	Author           string `json:"author"`
+	Started          string `json:"started"`
+	TimeSpent        string `json:"timeSpent"`
+	TimeSpentSeconds int    `json:"timeSpentSeconds"`
+	Comment          string `json:"comment,omitempty"`
+}
+
+// Link is an exported issue link, eg: the issue "blocks" TEST-2.
+type Link struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Relation string `json:"relation"`
+	Key      string `json:"key"`
+	Summary  string `json:"summary,omitempty"`
+}
+
+// Attachment is the metadata of an exported attachment.
+type Attachment struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	Author   string `json:"author"`
+	Created  string `json:"created"`
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+	URL      string `json:"url"`
+}
+
+// History is a set of field changes made at once.
+type History struct {
+	ID      string    `json:"id"`
+	Author  string    `json:"author"`
+	Created string    `json:"created"`
+	Items   []*Change `json:"items"`
+}
+
+// Change is a single field change in the issue history.
+type Change struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
+
+// Details holds the details of an issue that are fetched separately from the issue.
+type Details struct {
+	Comments  []*jira.Comment
+	Worklogs  []*jira.Worklog
+	Changelog []*jira.ChangelogHistory
+}
+
+// NewIssue builds the exported issue from the issue and its details.
+func NewIssue(iss *jira.Issue, details *Details) *Issue {
+	f := iss.Fields
+
+	out := Issue{
+		Key:          iss.Key,
+		Type:         f.IssueType.Name,
+		Status:       f.Status.Name,
+		Resolution:   f.Resolution.Name,
+		Priority:     f.Priority.Name,
+		Summary:      f.Summary,
+		Description:  toMarkdown(f.Description),
+		Assignee:     f.Assignee.Name,
+		Reporter:     f.Reporter.Name,
+		Labels:       f.Labels,
+		Created:      f.Created,
+		Updated:      f.Updated,
+		CustomFields: f.CustomFields,
+		Comments:     make([]*Comment, 0),
+		Worklogs:     make([]*Worklog, 0),
+		Links:        make([]*Link, 0, len(f.IssueLinks)),
+		Attachments:  make([]*Attachment, 0, len(f.Attachments)),
+		Changelog:    make([]*History, 0),
+	}
+
+	if f.Parent != nil {
+		out.Parent = f.Parent.Key
+	}
+	for _, st := range f.Subtasks {
+		out.Subtasks = append(out.Subtasks, st.Key)
+	}
+	for _, c := range f.Components {
+		out.Components = append(out.Components, c.Name)
+	}
+	for _, v := range f.FixVersions {
+		out.FixVersions = append(out.FixVersions, v.Name)
+	}
+	for _, v := range f.AffectsVersions {
+		out.AffectsVersions = append(out.AffectsVersions, v.Name)
+	}
+
+	for _, l := range f.IssueLinks {
+		link := Link{ID: l.ID, Type: l.LinkType.Name}
+
+		switch {
+		case l.OutwardIssue != nil:
+			link.Relation, link.Key, link.Summary = l.LinkType.Outward, l.OutwardIssue.Key, l.OutwardIssue.Fields.Summary
+		case l.InwardIssue != nil:
+			link.Relation, link.Key, link.Summary = l.LinkType.Inward, l.InwardIssue.Key, l.InwardIssue.Fields.Summary
+		default:
+			continue
+		}
+		out.Links = append(out.Links, &link)
+	}
+
+	for _, a := range f.Attachments {
+		out.Attachments = append(out.Attachments, &Attachment{
+			ID:       a.ID,
+			Filename: a.Filename,
+			Author:   userName(a.Author),
+			Created:  a.Created,
+			Size:     a.Size,
+			MimeType: a.MimeType,
+			URL:      a.Content,
+		})
+	}
+
+	if details == nil {
+		return &out
+	}
+
+	for _, c := range details.Comments {
+		out.Comments = append(out.Comments, &Comment{
+			ID:      c.ID,
+			Author:  userName(c.Author),
+			Created: c.Created,
+			Updated: c.Updated,
+			Body:    strings.TrimSpace(md.FromJiraMD(c.Body)),
+		})
+	}
+
+	for _, w := range details.Worklogs {
+		out.Worklogs = append(out.Worklogs, &Worklog{
+			ID:               w.ID,
+			Author:           userName(w.Author),
+			Started:          w.Started,
+			TimeSpent:        w.TimeSpent,
+			TimeSpentSeconds: w.TimeSpentSeconds,
+			Comment:          strings.TrimSpace(md.FromJiraMD(w.Comment)),
+		})
+	}
+
+	for _, h := range details.Changelog {
+		history := History{
+			ID:      h.ID,
+			Author:  userName(h.Author),
+			Created: h.Created,
+			Items:   make([]*Change, 0, len(h.Items)),
+		}
+		for _, item := range h.Items {
+			history.Items = append(history.Items, &Change{
+				Field: item.Field,
+				From:  changeValue(item.FromString, item.From),
+				To:    changeValue(item.ToString, item.To),
+			})
+		}
+		out.Changelog = append(out.Changelog, &history)
+	}
+
+	return &out
+}
+
+// TimeSpentSeconds returns the total time logged in the issue.
+func (i *Issue) TimeSpentSeconds() int {
+	var total int
+	for _, w := range i.Worklogs {
+		total += w.TimeSpentSeconds
+	}
+	return total
+}
+
+func toMarkdown(v interface{}) string {
+	switch val := v.(type) {
+	case *adf.ADF:
+		return strings.TrimSpace(adf.NewTranslator(val, adf.NewMarkdownTranslator()).Translate())
+	case string:
+		return strings.TrimSpace(md.FromJiraMD(val))
+	}
+	return ""
+}
+
+func userName(u jira.User) string {
+	switch {
+	case u.DisplayName != "":
+		return u.DisplayName
+	case u.Name != "":
+		return u.Name
+	}
+	return u.Email
+}
+
+// changeValue prefers the human readable value of a changed field over its id.
+func changeValue(str, raw string) string {
+	if str != "" {
+		return str
+	}
+	return raw
+}
@@ -0,0 +1,132 @@
+package export
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const issueJSON = `{
+	"key": "TEST-1",
+	"fields": {
+		"summary": "Checkout fails",
+		"description": "Steps to *reproduce*:\n* Add to cart\n* Pay",
+		"labels": ["payments"],
+		"resolution": {"name": "Fixed"},
+		"issueType": {"name": "Bug"},
+		"parent": {"key": "TEST-10"},
+		"assignee": {"displayName": "Jane Doe"},
+		"reporter": {"displayName": "John Doe"},
+		"priority": {"name": "High"},
+		"status": {"name": "Done"},
+		"components": [{"name": "Backend"}],
+		"fixVersions": [{"name": "v1.1"}],
+		"versions": [{"name": "v1.0"}],
+		"issueLinks": [
+			{"id": "100", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "TEST-2", "fields": {"summary": "Release"}}},
+			{"id": "101", "type": {"name": "Relates", "inward": "relates to", "outward": "relates to"}, "inwardIssue": {"key": "TEST-3", "fields": {"summary": "Refunds"}}}
+		],
+		"attachment": [{"id": "10", "filename": "trace.log", "author": {"displayName": "Jane Doe"}, "created": "2022-01-01T10:00:00.000+0000", "size": 42, "mimeType": "text/plain", "content": "https://jira.example.com/attachment/10"}],
+		"created": "2022-01-01T09:00:00.000+0000",
+		"updated": "2022-01-02T09:00:00.000+0000"
+	}
+}`
+
+func TestNewIssue(t *testing.T) {
+	t.Parallel()
+
+	var iss jira.Issue
+	assert.NoError(t, json.Unmarshal([]byte(issueJSON), &iss))
+
+	details := Details{
+		Comments: []*jira.Comment{
+			{ID: "1", Author: jira.User{Name: "jdoe"}, Body: "Looks *good*", Created: "2022-01-01T11:00:00.000+0000"},
+		},
+		Worklogs: []*jira.Worklog{
+			{ID: "2", Author: jira.User{DisplayName: "Jane Doe"}, Started: "2022-01-01T12:00:00.000+0000", TimeSpent: "1h", TimeSpentSeconds: 3600},
+			{ID: "3", Author: jira.User{Email: "jane@example.com"}, TimeSpent: "30m", TimeSpentSeconds: 1800, Comment: "Review"},
+		},
+		Changelog: []*jira.ChangelogHistory{
+			{
+				ID: "4", Author: jira.User{DisplayName: "Jane Doe"}, Created: "2022-01-02T09:00:00.000+0000",
+				Items: []*jira.ChangelogItem{
+					{Field: "status", From: "1", FromString: "To Do", To: "3", ToString: "Done"},
+					{Field: "Sprint", To: "7"},
+				},
+			},
+		},
+	}
+
+	actual := NewIssue(&iss, &details)
+
+	expected := &Issue{
+		Key:             "TEST-1",
+		Type:            "Bug",
+		Status:          "Done",
+		Resolution:      "Fixed",
+		Priority:        "High",
+		Summary:         "Checkout fails",
+		Description:     "Steps to **reproduce**:\n- Add to cart\n- Pay",
+		Assignee:        "Jane Doe",
+		Reporter:        "John Doe",
+		Parent:          "TEST-10",
+		Labels:          []string{"payments"},
+		Components:      []string{"Backend"},
+		FixVersions:     []string{"v1.1"},
+		AffectsVersions: []string{"v1.0"},
+		Created:         "2022-01-01T09:00:00.000+0000",
+		Updated:         "2022-01-02T09:00:00.000+0000",
+		Comments: []*Comment{
+			{ID: "1", Author: "jdoe", Created: "2022-01-01T11:00:00.000+0000", Body: "Looks **good**"},
+		},
+		Worklogs: []*Worklog{
+			{ID: "2", Author: "Jane Doe", Started: "2022-01-01T12:00:00.000+0000", TimeSpent: "1h", TimeSpentSeconds: 3600},
+			{ID: "3", Author: "jane@example.com", TimeSpent: "30m", TimeSpentSeconds: 1800, Comment: "Review"},
+		},
+		Links: []*Link{
+			{ID: "100", Type: "Blocks", Relation: "blocks", Key: "TEST-2", Summary: "Release"},
+			{ID: "101", Type: "Relates", Relation: "relates to", Key: "TEST-3", Summary: "Refunds"},
+		},
+		Attachments: []*Attachment{
+			{
+				ID: "10", Filename: "trace.log", Author: "Jane Doe", Created: "2022-01-01T10:00:00.000+0000",
+				Size: 42, MimeType: "text/plain", URL: "https://jira.example.com/attachment/10",
+			},
+		},
+		Changelog: []*History{
+			{
+				ID: "4", Author: "Jane Doe", Created: "2022-01-02T09:00:00.000+0000",
+				Items: []*Change{{Field: "status", From: "To Do", To: "Done"}, {Field: "Sprint", To: "7"}},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, 5400, actual.TimeSpentSeconds())
+}
+
+func TestNewIssueWithoutDetails(t *testing.T) {
+	t.Parallel()
+
+	iss := jira.Issue{Key: "TEST-2"}
+	iss.Fields.Summary = "ADF"
+	iss.Fields.Description = &adf.ADF{
+		Version: 1,
+		DocType: "doc",
+		Content: []*adf.Node{
+			{NodeType: "paragraph", Content: []*adf.Node{{NodeType: "text", NodeValue: adf.NodeValue{Text: "Hello"}}}},
+		},
+	}
+
+	actual := NewIssue(&iss, nil)
+
+	assert.Equal(t, "Hello", actual.Description)
+	assert.Empty(t, actual.Comments)
+	assert.NotNil(t, actual.Comments)
+	assert.Empty(t, actual.Changelog)
+	assert.Equal(t, 0, actual.TimeSpentSeconds())
+}
@@ -0,0 +1,264 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Supported export formats.
+const (
+	FormatJSON     = "json"
+	FormatNDJSON   = "ndjson"
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown"
+)
+
+// IndexFile is the name of the file listing the issues exported as markdown.
+const IndexFile = "index.md"
+
+// ErrUnknownFormat is returned if the export format is not supported.
+var ErrUnknownFormat = errors.New("unknown export format, supported formats are: json, ndjson, csv, markdown")
+
+// Writer writes the exported issues.
+type Writer interface {
+	// Write writes an issue.
+	Write(*Issue) error
+	// Close finishes the export, eg: closes the JSON list.
+	Close() error
+}
+
+// NewWriter returns a writer for the format. The markdown format writes a file per issue
+// to the given directory while the other formats write the issues to w.
+func NewWriter(format string, w io.Writer, dir string) (Writer, error) {
+	switch format {
+	case FormatJSON:
+		return &jsonWriter{w: w}, nil
+	case FormatNDJSON:
+		return &ndjsonWriter{enc: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatMarkdown:
+		if dir == "" {
+			return nil, fmt.Errorf("an output directory is required for the markdown format")
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		return &markdownWriter{dir: dir}, nil
+	}
+	return nil, ErrUnknownFormat
+}
+
+// jsonWriter streams the issues as a JSON list.
+type jsonWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (jw *jsonWriter) Write(iss *Issue) error {
+	data, err := json.MarshalIndent(iss, "  ", "  ")
+	if err != nil {
+		return err
+	}
+
+	sep := ",\n  "
+	if jw.count == 0 {
+		sep = "[\n  "
+	}
+	jw.count++
+
+	_, err = fmt.Fprintf(jw.w, "%s%s", sep, data)
+	return err
+}
+
+func (jw *jsonWriter) Close() error {
+	if jw.count == 0 {
+		_, err := fmt.Fprintln(jw.w, "[]")
+		return err
+	}
+	_, err := fmt.Fprint(jw.w, "\n]\n")
+	return err
+}
+
+// ndjsonWriter writes an issue per line.
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func (nw *ndjsonWriter) Write(iss *Issue) error {
+	return nw.enc.Encode(iss)
+}
+
+func (*ndjsonWriter) Close() error {
+	return nil
+}
+
+// csvWriter writes an issue per row. Comments, worklogs, links and attachments
+// are summarized as only their count, total time spent or keys and file names.
+type csvWriter struct {
+	w      *csv.Writer
+	header bool
+}
+
+func (cw *csvWriter) writeHeader() {
+	if cw.header {
+		return
+	}
+	cw.header = true
+
+	_ = cw.w.Write([]string{
+		"Key", "Type", "Status", "Resolution", "Priority", "Summary", "Assignee", "Reporter",
+		"Parent", "Labels", "Components", "Fix Versions", "Affects Versions", "Created", "Updated",
+		"Description", "Comments", "Time Spent (s)", "Links", "Attachments",
+	})
+}
+
+func (cw *csvWriter) Write(iss *Issue) error {
+	cw.writeHeader()
+
+	links := make([]string, 0, len(iss.Links))
+	for _, l := range iss.Links {
+		links = append(links, fmt.Sprintf("%s %s", l.Relation, l.Key))
+	}
+	attachments := make([]string, 0, len(iss.Attachments))
+	for _, a := range iss.Attachments {
+		attachments = append(attachments, a.Filename)
+	}
+
+	return cw.w.Write([]string{
+		iss.Key, iss.Type, iss.Status, iss.Resolution, iss.Priority, iss.Summary, iss.Assignee, iss.Reporter,
+		iss.Parent, strings.Join(iss.Labels, ","), strings.Join(iss.Components, ","),
+		strings.Join(iss.FixVersions, ","), strings.Join(iss.AffectsVersions, ","), iss.Created, iss.Updated,
+		iss.Description, strconv.Itoa(len(iss.Comments)), strconv.Itoa(iss.TimeSpentSeconds()),
+		strings.Join(links, "; "), strings.Join(attachments, "; "),
+	})
+}
+
+func (cw *csvWriter) Close() error {
+	cw.writeHeader()
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// markdownWriter writes a markdown file per issue and an index of the issues.
+type markdownWriter struct {
+	dir    string
+	issues []*Issue
+}
+
+func (mw *markdownWriter) Write(iss *Issue) error {
+	var b strings.Builder
+	RenderMarkdown(&b, iss)
+
+	// Only the fields listed in the index are kept to not hold the whole export in memory.
+	mw.issues = append(mw.issues, &Issue{Key: iss.Key, Type: iss.Type, Status: iss.Status, Summary: iss.Summary})
+
+	return os.WriteFile(filepath.Join(mw.dir, iss.Key+".md"), []byte(b.String()), 0o600)
+}
+
+func (mw *markdownWriter) Close() error {
+	var b strings.Builder
+
+	b.WriteString("# Issues\n\n| Key | Type | Status | Summary |\n| --- | --- | --- | --- |\n")
+	for _, iss := range mw.issues {
+		fmt.Fprintf(&b, "| [%s](%s.md) | %s | %s | %s |\n",
+			iss.Key, iss.Key, escapeCell(iss.Type), escapeCell(iss.Status), escapeCell(iss.Summary),
+		)
+	}
+
+	return os.WriteFile(filepath.Join(mw.dir, IndexFile), []byte(b.String()), 0o600)
+}
+
+// RenderMarkdown renders the issue as a markdown document.
+func RenderMarkdown(b *strings.Builder, iss *Issue) {
+	fmt.Fprintf(b, "# %s: %s\n\n", iss.Key, iss.Summary)
+
+	field := func(label, value string) {
+		if value != "" {
+			fmt.Fprintf(b, "- **%s:** %s\n", label, value)
+		}
+	}
+
+	field("Type", iss.Type)
+	field("Status", iss.Status)
+	field("Resolution", iss.Resolution)
+	field("Priority", iss.Priority)
+	field("Assignee", iss.Assignee)
+	field("Reporter", iss.Reporter)
+	field("Parent", iss.Parent)
+	field("Sub-tasks", strings.Join(iss.Subtasks, ", "))
+	field("Labels", strings.Join(iss.Labels, ", "))
+	field("Components", strings.Join(iss.Components, ", "))
+	field("Fix versions", strings.Join(iss.FixVersions, ", "))
+	field("Affects versions", strings.Join(iss.AffectsVersions, ", "))
+	field("Created", iss.Created)
+	field("Updated", iss.Updated)
+
+	if iss.Description != "" {
+		fmt.Fprintf(b, "\n## Description\n\n%s\n", iss.Description)
+	}
+
+	if len(iss.Comments) > 0 {
+		fmt.Fprintf(b, "\n## Comments\n")
+		for _, c := range iss.Comments {
+			fmt.Fprintf(b, "\n### %s on %s\n\n%s\n", c.Author, c.Created, strings.TrimSpace(c.Body))
+		}
+	}
+
+	if len(iss.Worklogs) > 0 {
+		fmt.Fprintf(b, "\n## Worklogs\n\n")
+		for _, w := range iss.Worklogs {
+			fmt.Fprintf(b, "- %s logged %s on %s", w.Author, w.TimeSpent, w.Started)
+			if c := strings.TrimSpace(w.Comment); c != "" {
+				fmt.Fprintf(b, ": %s", strings.ReplaceAll(c, "\n", " "))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(iss.Links) > 0 {
+		fmt.Fprintf(b, "\n## Links\n\n")
+		for _, l := range iss.Links {
+			fmt.Fprintf(b, "- %s %s", l.Relation, l.Key)
+			if l.Summary != "" {
+				fmt.Fprintf(b, ": %s", l.Summary)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(iss.Attachments) > 0 {
+		fmt.Fprintf(b, "\n## Attachments\n\n")
+		for _, a := range iss.Attachments {
+			fmt.Fprintf(b, "- [%s](%s) (%s, %d bytes) by %s on %s\n", a.Filename, a.URL, a.MimeType, a.Size, a.Author, a.Created)
+		}
+	}
+
+	if len(iss.Changelog) > 0 {
+		fmt.Fprintf(b, "\n## History\n\n")
+		for _, h := range iss.Changelog {
+			fmt.Fprintf(b, "- %s by %s\n", h.Created, h.Author)
+			for _, item := range h.Items {
+				fmt.Fprintf(b, "  - %s: %s → %s\n", item.Field, orDash(item.From), orDash(item.To))
+			}
+		}
+	}
+}
+
+func escapeCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,206 @@
+package export
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testIssues() []*Issue {
+	return []*Issue{
+		{
+			Key:         "TEST-1",
+			Type:        "Bug",
+			Status:      "Done",
+			Summary:     "Checkout fails | sometimes",
+			Description: "Steps to **reproduce**",
+			Assignee:    "Jane Doe",
+			Labels:      []string{"payments", "web"},
+			Created:     "2022-01-01T09:00:00.000+0000",
+			Updated:     "2022-01-02T09:00:00.000+0000",
+			Comments:    []*Comment{{ID: "1", Author: "John Doe", Created: "2022-01-01T11:00:00.000+0000", Body: "Looks good"}},
+			Worklogs: []*Worklog{
+				{ID: "2", Author: "Jane Doe", Started: "2022-01-01T12:00:00.000+0000", TimeSpent: "1h", TimeSpentSeconds: 3600, Comment: "Fix\nand test"},
+			},
+			Links:       []*Link{{ID: "100", Type: "Blocks", Relation: "blocks", Key: "TEST-2", Summary: "Release"}},
+			Attachments: []*Attachment{{ID: "10", Filename: "trace.log", Author: "Jane Doe", Created: "2022-01-01T10:00:00.000+0000", Size: 42, MimeType: "text/plain", URL: "https://jira.example.com/attachment/10"}},
+			Changelog: []*History{
+				{ID: "4", Author: "Jane Doe", Created: "2022-01-02T09:00:00.000+0000", Items: []*Change{{Field: "status", From: "To Do", To: "Done"}, {Field: "Sprint", To: "7"}}},
+			},
+		},
+		{
+			Key:     "TEST-2",
+			Type:    "Task",
+			Status:  "To Do",
+			Summary: "Release",
+			Created: "2022-01-03T09:00:00.000+0000",
+			Updated: "2022-01-03T09:00:00.000+0000",
+		},
+	}
+}
+
+func writeAll(t *testing.T, w Writer, issues []*Issue) {
+	t.Helper()
+
+	for _, iss := range issues {
+		assert.NoError(t, w.Write(iss))
+	}
+	assert.NoError(t, w.Close())
+}
+
+func TestJSONWriter(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+
+	w, err := NewWriter(FormatJSON, &b, "")
+	assert.NoError(t, err)
+	writeAll(t, w, []*Issue{{Key: "TEST-1"}, {Key: "TEST-2"}})
+
+	expected := `[
+  {
+    "key": "TEST-1",
+    "type": "",
+    "status": "",
+    "summary": "",
+    "created": "",
+    "updated": "",
+    "comments": null,
+    "worklogs": null,
+    "links": null,
+    "attachments": null,
+    "changelog": null
+  },
+  {
+    "key": "TEST-2",
+    "type": "",
+    "status": "",
+    "summary": "",
+    "created": "",
+    "updated": "",
+    "comments": null,
+    "worklogs": null,
+    "links": null,
+    "attachments": null,
+    "changelog": null
+  }
+]
+`
+	assert.Equal(t, expected, b.String())
+
+	b.Reset()
+	w, err = NewWriter(FormatJSON, &b, "")
+	assert.NoError(t, err)
+	writeAll(t, w, nil)
+	assert.Equal(t, "[]\n", b.String())
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+
+	w, err := NewWriter(FormatNDJSON, &b, "")
+	assert.NoError(t, err)
+	writeAll(t, w, testIssues()[1:])
+
+	expected := `{"key":"TEST-2","type":"Task","status":"To Do","summary":"Release","created":"2022-01-03T09:00:00.000+0000",` +
+		`"updated":"2022-01-03T09:00:00.000+0000","comments":null,"worklogs":null,"links":null,"attachments":null,"changelog":null}` + "\n"
+	assert.Equal(t, expected, b.String())
+}
+
+func TestCSVWriter(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+
+	w, err := NewWriter(FormatCSV, &b, "")
+	assert.NoError(t, err)
+	writeAll(t, w, testIssues())
+
+	expected := `Key,Type,Status,Resolution,Priority,Summary,Assignee,Reporter,Parent,Labels,Components,Fix Versions,Affects Versions,Created,Updated,Description,Comments,Time Spent (s),Links,Attachments
+TEST-1,Bug,Done,,,Checkout fails | sometimes,Jane Doe,,,"payments,web",,,,2022-01-01T09:00:00.000+0000,2022-01-02T09:00:00.000+0000,Steps to **reproduce**,1,3600,blocks TEST-2,trace.log
+TEST-2,Task,To Do,,,Release,,,,,,,,2022-01-03T09:00:00.000+0000,2022-01-03T09:00:00.000+0000,,0,0,,
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestMarkdownWriter(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "issues")
+
+	w, err := NewWriter(FormatMarkdown, nil, dir)
+	assert.NoError(t, err)
+	writeAll(t, w, testIssues())
+
+	expected := `# TEST-1: Checkout fails | sometimes
+
+- **Type:** Bug
+- **Status:** Done
+- **Assignee:** Jane Doe
+- **Labels:** payments, web
+- **Created:** 2022-01-01T09:00:00.000+0000
+- **Updated:** 2022-01-02T09:00:00.000+0000
+
+## Description
+
+Steps to **reproduce**
+
+## Comments
+
+### John Doe on 2022-01-01T11:00:00.000+0000
+
+Looks good
+
+## Worklogs
+
+- Jane Doe logged 1h on 2022-01-01T12:00:00.000+0000: Fix and test
+
+## Links
+
+- blocks TEST-2: Release
+
+## Attachments
+
+- [trace.log](https://jira.example.com/attachment/10) (text/plain, 42 bytes) by Jane Doe on 2022-01-01T10:00:00.000+0000
+
+## History
+
+- 2022-01-02T09:00:00.000+0000 by Jane Doe
+  - status: To Do → Done
+  - Sprint: - → 7
+`
+	actual, err := os.ReadFile(filepath.Join(dir, "TEST-1.md"))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(actual))
+
+	actual, err = os.ReadFile(filepath.Join(dir, "TEST-2.md"))
+	assert.NoError(t, err)
+	assert.Equal(t, "# TEST-2: Release\n\n- **Type:** Task\n- **Status:** To Do\n"+
+		"- **Created:** 2022-01-03T09:00:00.000+0000\n- **Updated:** 2022-01-03T09:00:00.000+0000\n", string(actual))
+
+	expected = `# Issues
+
+| Key | Type | Status | Summary |
+| --- | --- | --- | --- |
+| [TEST-1](TEST-1.md) | Bug | Done | Checkout fails \| sometimes |
+| [TEST-2](TEST-2.md) | Task | To Do | Release |
+`
+	actual, err = os.ReadFile(filepath.Join(dir, IndexFile))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(actual))
+}
+
+func TestNewWriterErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewWriter("xml", nil, "")
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+
+	_, err = NewWriter(FormatMarkdown, nil, "")
+	assert.EqualError(t, err, "an output directory is required for the markdown format")
+}
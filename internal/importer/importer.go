@@ -0,0 +1,451 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Supported import file formats.
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+const customColumnPrefix = "custom."
+
+// ErrUnknownFormat is returned if the format of the import file is not supported.
+var ErrUnknownFormat = errors.New("unknown import file format, supported formats are: csv, json, yaml")
+
+// Issue is an issue to import.
+//
+// The ID is a temporary identifier of the issue within the import file that can be used
+// to reference the issue as a parent, epic or a link target of other issues in the file.
+type Issue struct {
+	ID               string   `json:"id" yaml:"id"`
+	Type             string   `json:"type" yaml:"type"`
+	Name             string   `json:"name" yaml:"name"`
+	Summary          string   `json:"summary" yaml:"summary"`
+	Body             string   `json:"body" yaml:"body"`
+	Priority         string   `json:"priority" yaml:"priority"`
+	Assignee         string   `json:"assignee" yaml:"assignee"`
+	Reporter         string   `json:"reporter" yaml:"reporter"`
+	Parent           string   `json:"parent" yaml:"parent"`
+	Epic             string   `json:"epic" yaml:"epic"`
+	Labels           []string `json:"labels" yaml:"labels"`
+	Components       []string `json:"components" yaml:"components"`
+	FixVersions      []string `json:"fix-versions" yaml:"fix-versions"`
+	AffectsVersions  []string `json:"affects-versions" yaml:"affects-versions"`
+	OriginalEstimate string   `json:"original-estimate" yaml:"original-estimate"`
+	CustomFields     Values   `json:"custom" yaml:"custom"`
+	Links            []*Link  `json:"links" yaml:"links"`
+	Subtasks         []*Issue `json:"subtasks" yaml:"subtasks"`
+
+	// Pos is the position of the issue in the import file, eg: row 2.
+	Pos string `json:"-" yaml:"-"`
+	// Key is the key of the issue once it is created.
+	Key string `json:"-" yaml:"-"`
+
+	subtaskOf *Issue
+	parent    *Issue
+	parentKey string
+	links     []*resolvedLink
+	issueType *jira.CreateMetaIssueType
+}
+
+// Link links the issue to the target issue with the given link type. The issue is the
+// inward issue of the link, ie: same as `jira issue link ISSUE TARGET TYPE`.
+type Link struct {
+	Type  string `json:"type" yaml:"type"`
+	Issue string `json:"issue" yaml:"issue"`
+}
+
+type resolvedLink struct {
+	typ    string
+	target *Issue
+	key    string
+}
+
+// Values holds custom field values. Numbers, booleans and lists are converted to
+// the format accepted by the --custom flag, eg: a list is joined with commas.
+type Values map[string]string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Values) UnmarshalJSON(data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*v = toValues(raw)
+	return nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (v *Values) UnmarshalYAML(node *yaml.Node) error {
+	var raw map[string]interface{}
+	if err := node.Decode(&raw); err != nil {
+		return err
+	}
+	*v = toValues(raw)
+	return nil
+}
+
+// FormatFromPath returns the import format based on the file extension.
+func FormatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV
+	case ".json":
+		return FormatJSON
+	case ".yml", ".yaml":
+		return FormatYAML
+	}
+	return ""
+}
+
+// Load reads the issues from the file. The format is detected from the file extension.
+func Load(path string) ([]*Issue, error) {
+	format := FormatFromPath(path)
+	if format == "" {
+		return nil, ErrUnknownFormat
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	return Parse(f, format)
+}
+
+// Parse parses the issues in the given format. Sub-tasks nested in JSON and YAML
+// documents are flattened and follow their parent in the returned list.
+//
+// JSON and YAML documents are either a list of issues or an object with the list of
+// issues under the "issues" key. The first row of a CSV file is the header with the
+// field names. Multiple values are separated with commas or passed in repeated columns,
+// and custom fields are passed in columns prefixed with "custom.", eg: custom.story-points.
+func Parse(r io.Reader, format string) ([]*Issue, error) {
+	var (
+		issues []*Issue
+		err    error
+	)
+
+	switch format {
+	case FormatCSV:
+		return parseCSV(r)
+	case FormatJSON:
+		issues, err = parseJSON(r)
+	case FormatYAML:
+		issues, err = parseYAML(r)
+	default:
+		return nil, ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*Issue
+	for i, iss := range issues {
+		out = append(out, flatten(iss, fmt.Sprintf("issue %d", i+1))...)
+	}
+	return out, nil
+}
+
+func parseJSON(r io.Reader) ([]*Issue, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc struct {
+		Issues []*Issue `json:"issues"`
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = dec.Decode(&doc.Issues)
+	} else {
+		err = dec.Decode(&doc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+	return doc.Issues, nil
+}
+
+func parseYAML(r io.Reader) ([]*Issue, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("invalid yaml: %w", err)
+	}
+	if len(root.Content) == 0 {
+		return nil, nil
+	}
+
+	var doc struct {
+		Issues []*Issue `yaml:"issues"`
+	}
+
+	// Nodes don't support rejecting the unknown fields, so the document is decoded again.
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	if root.Content[0].Kind == yaml.SequenceNode {
+		err = dec.Decode(&doc.Issues)
+	} else {
+		err = dec.Decode(&doc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid yaml: %w", err)
+	}
+	return doc.Issues, nil
+}
+
+func flatten(iss *Issue, pos string) []*Issue {
+	if iss == nil {
+		return nil
+	}
+	iss.Pos = pos
+
+	// Empty list items, eg: "links: [null]", are dropped.
+	links := iss.Links[:0]
+	for _, l := range iss.Links {
+		if l != nil {
+			links = append(links, l)
+		}
+	}
+	iss.Links = links
+
+	out := []*Issue{iss}
+	for i, st := range iss.Subtasks {
+		if st == nil {
+			continue
+		}
+		st.subtaskOf = iss
+		out = append(out, flatten(st, fmt.Sprintf("%s.%d", pos, i+1))...)
+	}
+	iss.Subtasks = nil
+
+	return out
+}
+
+func parseCSV(r io.Reader) ([]*Issue, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("invalid csv: %w", err)
+	}
+
+	columns := make([]string, 0, len(header))
+	for i, h := range header {
+		if i == 0 {
+			h = strings.TrimPrefix(h, "\ufeff")
+		}
+		col, err := csvColumn(h)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, col)
+	}
+
+	var issues []*Issue
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+		if isEmptyRecord(record) {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		iss := Issue{Pos: fmt.Sprintf("row %d", line)}
+		for i, val := range record {
+			if err := iss.set(columns[i], strings.TrimSpace(val)); err != nil {
+				return nil, fmt.Errorf("%s: %w", iss.Pos, err)
+			}
+		}
+		issues = append(issues, &iss)
+	}
+
+	return issues, nil
+}
+
+// csvColumn normalizes the CSV header, eg: "Fix Version" becomes "fix-versions".
+func csvColumn(header string) (string, error) {
+	h := strings.TrimSpace(header)
+
+	if name, ok := cutPrefixFold(h, customColumnPrefix); ok && strings.TrimSpace(name) != "" {
+		return customColumnPrefix + customFieldName(name), nil
+	}
+
+	col := strings.Join(strings.FieldsFunc(strings.ToLower(h), func(r rune) bool {
+		return r == ' ' || r == '_' || r == '-'
+	}), "-")
+
+	switch col {
+	case "id", "type", "name", "summary", "body", "priority", "assignee", "reporter",
+		"parent", "epic", "labels", "components", "fix-versions", "affects-versions",
+		"original-estimate", "links":
+		return col, nil
+	case "issue-type", "issuetype":
+		return "type", nil
+	case "epic-name":
+		return "name", nil
+	case "description":
+		return "body", nil
+	case "epic-link":
+		return "epic", nil
+	case "label", "component", "fix-version", "affects-version", "link":
+		return col + "s", nil
+	case "estimate":
+		return "original-estimate", nil
+	}
+	return "", fmt.Errorf("unsupported column %q", header)
+}
+
+func (i *Issue) set(column, val string) error {
+	if val == "" {
+		return nil
+	}
+
+	if name, ok := strings.CutPrefix(column, customColumnPrefix); ok {
+		if i.CustomFields == nil {
+			i.CustomFields = make(Values)
+		}
+		if cur, ok := i.CustomFields[name]; ok {
+			val = cur + "," + val
+		}
+		i.CustomFields[name] = val
+		return nil
+	}
+
+	switch column {
+	case "id":
+		i.ID = val
+	case "type":
+		i.Type = val
+	case "name":
+		i.Name = val
+	case "summary":
+		i.Summary = val
+	case "body":
+		i.Body = val
+	case "priority":
+		i.Priority = val
+	case "assignee":
+		i.Assignee = val
+	case "reporter":
+		i.Reporter = val
+	case "parent":
+		i.Parent = val
+	case "epic":
+		i.Epic = val
+	case "original-estimate":
+		i.OriginalEstimate = val
+	case "labels":
+		i.Labels = append(i.Labels, splitValues(val)...)
+	case "components":
+		i.Components = append(i.Components, splitValues(val)...)
+	case "fix-versions":
+		i.FixVersions = append(i.FixVersions, splitValues(val)...)
+	case "affects-versions":
+		i.AffectsVersions = append(i.AffectsVersions, splitValues(val)...)
+	case "links":
+		for _, l := range splitValues(val) {
+			typ, target, ok := strings.Cut(l, ":")
+			if !ok || strings.TrimSpace(typ) == "" || strings.TrimSpace(target) == "" {
+				return fmt.Errorf("invalid link %q, expected link type and issue, eg: Blocks:ISSUE-1", l)
+			}
+			i.Links = append(i.Links, &Link{Type: strings.TrimSpace(typ), Issue: strings.TrimSpace(target)})
+		}
+	}
+	return nil
+}
+
+func splitValues(val string) []string {
+	var out []string
+	for _, v := range strings.Split(val, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func isEmptyRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
+
+// customFieldName converts the custom field name to the identifier used by the --custom flag.
+func customFieldName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+}
+
+func toValues(raw map[string]interface{}) Values {
+	out := make(Values, len(raw))
+	for k, v := range raw {
+		out[customFieldName(k)] = stringify(v)
+	}
+	return out
+}
+
+func stringify(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case []interface{}:
+		items := make([]string, 0, len(val))
+		for _, item := range val {
+			items = append(items, stringify(item))
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,118 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const csvIssues = "\ufeffID,Issue Type,Summary,Description,Labels,Labels,Fix Version,Epic Link,Parent,Custom.Story Points,custom.platforms,custom.platforms,Links\n" +
+	"E1,Epic,Checkout,,,,,,,,,,\n" +
+	"S1,Story,Pay with card,\"Accept **cards**\",payments,\"backend, api\",v1.0,E1,,3,web,ios,\"Blocks:S2, Relates:TEST-7\"\n" +
+	",,,,,,,,,,,,\n" +
+	"S2,Story,Refunds,,,,,E1,,,,,\n" +
+	"T1,Sub-task,Add tests,,,,,,S1,,,,\n"
+
+func TestParseCSV(t *testing.T) {
+	t.Parallel()
+
+	issues, err := Parse(strings.NewReader(csvIssues), FormatCSV)
+	assert.NoError(t, err)
+	assert.Len(t, issues, 4)
+
+	s1 := issues[1]
+	assert.Equal(t, "row 3", s1.Pos)
+	assert.Equal(t, "S1", s1.ID)
+	assert.Equal(t, "Story", s1.Type)
+	assert.Equal(t, "Pay with card", s1.Summary)
+	assert.Equal(t, "Accept **cards**", s1.Body)
+	assert.Equal(t, []string{"payments", "backend", "api"}, s1.Labels)
+	assert.Equal(t, []string{"v1.0"}, s1.FixVersions)
+	assert.Equal(t, "E1", s1.Epic)
+	assert.Equal(t, Values{"story-points": "3", "platforms": "web,ios"}, s1.CustomFields)
+	assert.Equal(t, []*Link{{Type: "Blocks", Issue: "S2"}, {Type: "Relates", Issue: "TEST-7"}}, s1.Links)
+
+	// Empty rows are skipped.
+	assert.Equal(t, "row 5", issues[2].Pos)
+	assert.Equal(t, "S1", issues[3].Parent)
+
+	_, err = Parse(strings.NewReader("summary,size\nA,1\n"), FormatCSV)
+	assert.EqualError(t, err, `unsupported column "size"`)
+
+	_, err = Parse(strings.NewReader("summary,links\nA,Blocks\n"), FormatCSV)
+	assert.EqualError(t, err, `row 2: invalid link "Blocks", expected link type and issue, eg: Blocks:ISSUE-1`)
+}
+
+func TestParseJSONAndYAML(t *testing.T) {
+	t.Parallel()
+
+	jsonIssues := `{"issues": [
+  {"id": "S1", "type": "Story", "summary": "Pay with card", "custom": {"story-points": 3, "Platforms": ["web", "ios"]},
+   "links": [{"type": "Blocks", "issue": "TEST-7"}],
+   "subtasks": [{"type": "Sub-task", "summary": "Add tests"}, {"type": "Sub-task", "summary": "Docs"}]},
+  {"type": "Task", "summary": "Deploy", "fix-versions": ["v1.0"]}
+]}`
+
+	issues, err := Parse(strings.NewReader(jsonIssues), FormatJSON)
+	assert.NoError(t, err)
+	assert.Len(t, issues, 4)
+	assert.Equal(t, []string{"issue 1", "issue 1.1", "issue 1.2", "issue 2"}, positions(issues))
+	assert.Equal(t, Values{"story-points": "3", "platforms": "web,ios"}, issues[0].CustomFields)
+	assert.Same(t, issues[0], issues[1].subtaskOf)
+	assert.Equal(t, []string{"v1.0"}, issues[3].FixVersions)
+
+	yamlIssues := `
+- id: S1
+  type: Story
+  summary: Pay with card
+  custom:
+    story-points: 3
+  links: [null, {type: Blocks, issue: TEST-7}]
+  subtasks:
+    - type: Sub-task
+      summary: Add tests
+- type: Task
+  summary: Deploy
+`
+	issues, err = Parse(strings.NewReader(yamlIssues), FormatYAML)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"issue 1", "issue 1.1", "issue 2"}, positions(issues))
+	assert.Equal(t, Values{"story-points": "3"}, issues[0].CustomFields)
+	assert.Equal(t, []*Link{{Type: "Blocks", Issue: "TEST-7"}}, issues[0].Links)
+
+	_, err = Parse(strings.NewReader(`[{"summary": "A", "size": 1}]`), FormatJSON)
+	assert.EqualError(t, err, `invalid json: json: unknown field "size"`)
+
+	_, err = Parse(strings.NewReader("- summary: A\n  size: 1\n"), FormatYAML)
+	assert.Error(t, err)
+
+	_, err = Parse(strings.NewReader(""), "xml")
+	assert.Equal(t, ErrUnknownFormat, err)
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "issues.yml")
+	assert.NoError(t, os.WriteFile(file, []byte("issues:\n  - type: Task\n    summary: Deploy\n"), 0o600))
+
+	issues, err := Load(file)
+	assert.NoError(t, err)
+	assert.Len(t, issues, 1)
+	assert.Equal(t, "Deploy", issues[0].Summary)
+
+	_, err = Load(filepath.Join(dir, "issues.txt"))
+	assert.Equal(t, ErrUnknownFormat, err)
+}
+
+func positions(issues []*Issue) []string {
+	out := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		out = append(out, iss.Pos)
+	}
+	return out
+}
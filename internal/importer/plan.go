@@ -0,0 +1,263 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+// ValidationError holds the problems found in the issues to import.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid import file:\n  - %s", strings.Join(e.Problems, "\n  - "))
+}
+
+type problems []string
+
+func (p *problems) add(iss *Issue, format string, a ...interface{}) {
+	*p = append(*p, fmt.Sprintf("%s: %s", iss.Pos, fmt.Sprintf(format, a...)))
+}
+
+func (p problems) err() error {
+	if len(p) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: p}
+}
+
+// Plan resolves the references between the issues and returns them in the order they need
+// to be created in, ie: parents and epics are created before the issues referencing them.
+//
+// A reference is either the ID of an issue in the file or an issue key. References that are
+// not IDs of the issues in the file and are numeric are prefixed with the project key.
+func Plan(issues []*Issue, project string) ([]*Issue, error) {
+	var errs problems
+
+	ids := make(map[string]*Issue, len(issues))
+	for _, iss := range issues {
+		if iss.ID == "" {
+			continue
+		}
+		if prev, ok := ids[iss.ID]; ok {
+			errs.add(iss, "duplicate id %q, already used in %s", iss.ID, prev.Pos)
+			continue
+		}
+		ids[iss.ID] = iss
+	}
+
+	resolve := func(ref string) (*Issue, string, bool) {
+		if iss, ok := ids[ref]; ok {
+			return iss, "", true
+		}
+		if cmdutil.IsIssueKey(ref) {
+			return nil, cmdutil.GetJiraIssueKey(project, ref), true
+		}
+		return nil, "", false
+	}
+
+	for _, iss := range issues {
+		if strings.TrimSpace(iss.Summary) == "" {
+			errs.add(iss, "summary is required")
+		}
+		if strings.TrimSpace(iss.Type) == "" {
+			errs.add(iss, "type is required")
+		}
+
+		iss.parent, iss.parentKey = iss.subtaskOf, ""
+
+		field, ref := "parent", iss.Parent
+		if iss.Epic != "" {
+			field, ref = "epic", iss.Epic
+		}
+		switch {
+		case iss.Parent != "" && iss.Epic != "":
+			errs.add(iss, "only one of parent and epic can be set")
+		case iss.subtaskOf != nil && ref != "":
+			errs.add(iss, "a nested sub-task can't have a parent or an epic")
+		case ref != "":
+			parent, key, ok := resolve(ref)
+			if !ok {
+				errs.add(iss, "unknown issue %q referenced as %s", ref, field)
+				break
+			}
+			if parent == iss {
+				errs.add(iss, "the issue can't be its own %s", field)
+				break
+			}
+			iss.parent, iss.parentKey = parent, key
+		}
+
+		iss.links = nil
+		for _, l := range iss.Links {
+			if l == nil {
+				continue
+			}
+			if l.Type == "" || l.Issue == "" {
+				errs.add(iss, "both the type and the issue of a link are required")
+				continue
+			}
+			target, key, ok := resolve(l.Issue)
+			if !ok {
+				errs.add(iss, "unknown issue %q referenced in links", l.Issue)
+				continue
+			}
+			if target == iss {
+				errs.add(iss, "the issue can't be linked to itself")
+				continue
+			}
+			iss.links = append(iss.links, &resolvedLink{typ: l.Type, target: target, key: key})
+		}
+	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	var (
+		out   = make([]*Issue, 0, len(issues))
+		state = make(map[*Issue]int, len(issues))
+		visit func(*Issue) bool
+	)
+
+	visit = func(iss *Issue) bool {
+		switch state[iss] {
+		case visited:
+			return true
+		case visiting:
+			return false
+		}
+		state[iss] = visiting
+		if iss.parent != nil && !visit(iss.parent) {
+			return false
+		}
+		state[iss] = visited
+		out = append(out, iss)
+		return true
+	}
+
+	for _, iss := range issues {
+		if !visit(iss) {
+			errs.add(iss, "circular parent or epic reference")
+			return nil, errs.err()
+		}
+	}
+
+	return out, nil
+}
+
+// ParentKey returns the key of the parent or the epic of the issue. It returns false
+// if the parent is an issue in the file that is not created, eg: because it failed.
+func (i *Issue) ParentKey() (string, bool) {
+	if i.parent != nil {
+		return i.parent.Key, i.parent.Key != ""
+	}
+	return i.parentKey, true
+}
+
+// Ref returns the ID of the issue or its position in the file if it has no ID.
+func (i *Issue) Ref() string {
+	if i.ID != "" {
+		return i.ID
+	}
+	return i.Pos
+}
+
+// ParentRef returns the reference to the parent or the epic of the issue.
+func (i *Issue) ParentRef() string {
+	if i.parent != nil {
+		return i.parent.Ref()
+	}
+	return i.parentKey
+}
+
+// IssueType returns the issue type resolved by Meta.Validate.
+func (i *Issue) IssueType() string {
+	if i.issueType == nil {
+		return i.Type
+	}
+	return i.issueType.Name
+}
+
+// IssueTypeID returns the ID of the issue type resolved by Meta.Validate.
+func (i *Issue) IssueTypeID() string {
+	if i.issueType == nil {
+		return ""
+	}
+	return i.issueType.ID
+}
+
+// IsSubtask checks if the issue type resolved by Meta.Validate is a sub-task.
+func (i *Issue) IsSubtask() bool {
+	return i.issueType != nil && i.issueType.Subtask
+}
+
+// IsEpic checks if the issue type resolved by Meta.Validate is an epic.
+func (i *Issue) IsEpic() bool {
+	return i.issueType != nil && isEpic(i.issueType)
+}
+
+// IssueLinks returns the links of the issue with the references replaced with the issue
+// keys. The issue of a link is empty if it references an issue that is not created.
+func (i *Issue) IssueLinks() []*Link {
+	out := make([]*Link, 0, len(i.links))
+	for _, l := range i.links {
+		key := l.key
+		if l.target != nil {
+			key = l.target.Key
+		}
+		out = append(out, &Link{Type: l.typ, Issue: key})
+	}
+	return out
+}
+
+type mapping struct {
+	ID      string `json:"id" yaml:"id"`
+	Key     string `json:"key" yaml:"key"`
+	Summary string `json:"summary" yaml:"summary"`
+}
+
+// WriteMapping writes the IDs and the keys of the created issues in the given format.
+func WriteMapping(w io.Writer, issues []*Issue, format string) error {
+	rows := make([]mapping, 0, len(issues))
+	for _, iss := range issues {
+		if iss.Key != "" {
+			rows = append(rows, mapping{ID: iss.ID, Key: iss.Key, Summary: iss.Summary})
+		}
+	}
+
+	switch format {
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		_ = cw.Write([]string{"id", "key", "summary"})
+		for _, r := range rows {
+			_ = cw.Write([]string{r.ID, r.Key, r.Summary})
+		}
+		cw.Flush()
+		return cw.Error()
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	case FormatYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(rows); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+	return ErrUnknownFormat
+}
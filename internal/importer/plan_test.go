@@ -0,0 +1,108 @@
+package importer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	issues, err := Parse(strings.NewReader(csvIssues), FormatCSV)
+	assert.NoError(t, err)
+
+	planned, err := Plan(issues, "TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"E1", "S1", "S2", "T1"}, ids(planned))
+
+	// Issues referencing an issue defined later in the file are created after it.
+	planned, err = Plan([]*Issue{issues[3], issues[2], issues[1], issues[0]}, "TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"E1", "S1", "T1", "S2"}, ids(planned))
+
+	s1 := planned[1]
+	assert.Equal(t, "E1", s1.ParentRef())
+	assert.Equal(t, "S1", planned[2].ParentRef())
+	key, ok := s1.ParentKey()
+	assert.False(t, ok)
+	assert.Empty(t, key)
+
+	planned[0].Key = "TEST-1"
+	key, ok = s1.ParentKey()
+	assert.True(t, ok)
+	assert.Equal(t, "TEST-1", key)
+
+	assert.Equal(t, []*Link{{Type: "Blocks", Issue: ""}, {Type: "Relates", Issue: "TEST-7"}}, s1.IssueLinks())
+	planned[3].Key = "TEST-3"
+	assert.Equal(t, []*Link{{Type: "Blocks", Issue: "TEST-3"}, {Type: "Relates", Issue: "TEST-7"}}, s1.IssueLinks())
+
+	// Numeric references that are not IDs are issue numbers in the project.
+	planned, err = Plan([]*Issue{{Type: "Task", Summary: "A", Epic: "12"}}, "TEST")
+	assert.NoError(t, err)
+	key, ok = planned[0].ParentKey()
+	assert.True(t, ok)
+	assert.Equal(t, "TEST-12", key)
+	assert.Equal(t, "TEST-12", planned[0].ParentRef())
+}
+
+func TestPlanErrors(t *testing.T) {
+	t.Parallel()
+
+	issues := []*Issue{
+		{Pos: "row 2", ID: "A", Type: "Task", Summary: "A", Parent: "B", Epic: "C"},
+		{Pos: "row 3", ID: "A", Type: "Task", Summary: "Duplicate"},
+		{Pos: "row 4", ID: "B", Summary: "No type", Epic: "unknown"},
+		{Pos: "row 5", ID: "C", Type: "Task", Links: []*Link{{Type: "Blocks", Issue: "C"}, {Type: "Blocks"}}},
+	}
+
+	_, err := Plan(issues, "TEST")
+	assert.EqualError(t, err, "invalid import file:\n"+
+		"  - row 3: duplicate id \"A\", already used in row 2\n"+
+		"  - row 2: only one of parent and epic can be set\n"+
+		"  - row 4: type is required\n"+
+		"  - row 4: unknown issue \"unknown\" referenced as epic\n"+
+		"  - row 5: summary is required\n"+
+		"  - row 5: the issue can't be linked to itself\n"+
+		"  - row 5: both the type and the issue of a link are required",
+	)
+
+	_, err = Plan([]*Issue{
+		{Pos: "row 2", ID: "A", Type: "Task", Summary: "A", Epic: "B"},
+		{Pos: "row 3", ID: "B", Type: "Epic", Summary: "B", Epic: "A"},
+	}, "TEST")
+	assert.EqualError(t, err, "invalid import file:\n  - row 2: circular parent or epic reference")
+}
+
+func TestWriteMapping(t *testing.T) {
+	t.Parallel()
+
+	issues := []*Issue{
+		{ID: "E1", Summary: "Checkout", Key: "TEST-1"},
+		{ID: "S1", Summary: "Failed"},
+		{Summary: "Pay, with card", Key: "TEST-2"},
+	}
+
+	var b bytes.Buffer
+
+	assert.NoError(t, WriteMapping(&b, issues, FormatCSV))
+	assert.Equal(t, "id,key,summary\nE1,TEST-1,Checkout\n,TEST-2,\"Pay, with card\"\n", b.String())
+
+	b.Reset()
+	assert.NoError(t, WriteMapping(&b, issues[:1], FormatJSON))
+	assert.Equal(t, "[\n  {\n    \"id\": \"E1\",\n    \"key\": \"TEST-1\",\n    \"summary\": \"Checkout\"\n  }\n]\n", b.String())
+
+	b.Reset()
+	assert.NoError(t, WriteMapping(&b, issues[:1], FormatYAML))
+	assert.Equal(t, "- id: E1\n  key: TEST-1\n  summary: Checkout\n", b.String())
+}
+
+func ids(issues []*Issue) []string {
+	out := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		out = append(out, iss.ID)
+	}
+	return out
+}
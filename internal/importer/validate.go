@@ -0,0 +1,212 @@
+package importer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Meta is the create metadata of the project the issues are validated against.
+type Meta struct {
+	IssueTypes []*jira.CreateMetaIssueType
+	// CustomFields are the custom fields configured in the config file.
+	CustomFields []jira.IssueTypeField
+	// EpicName and EpicLink are the keys of the epic name and the epic link fields.
+	EpicName string
+	EpicLink string
+}
+
+// Validate validates the issues planned with Plan against the create metadata, ie: the issue
+// types exist, the fields are available on the create screen of the issue type, the values
+// are allowed and the required fields are set. The issue types of the issues are resolved.
+func (m *Meta) Validate(issues []*Issue) error {
+	var errs problems
+
+	for _, iss := range issues {
+		iss.issueType = m.issueType(iss.Type)
+		if iss.issueType == nil {
+			errs.add(iss, "unknown issue type %q, available types are: %s", iss.Type, m.issueTypeNames())
+		}
+	}
+
+	configured := make(map[string]jira.IssueTypeField, len(m.CustomFields))
+	for _, f := range m.CustomFields {
+		configured[customFieldName(f.Name)] = f
+	}
+
+	for _, iss := range issues {
+		it := iss.issueType
+		if it == nil {
+			continue
+		}
+
+		hasParent := iss.parent != nil || iss.parentKey != ""
+		switch {
+		case it.Subtask && (!hasParent || iss.Epic != ""):
+			errs.add(iss, "parent is required for a sub-task")
+		case it.Subtask && iss.parent != nil && iss.parent.IsSubtask():
+			errs.add(iss, "the parent %s can't be a sub-task", iss.parent.Pos)
+		case !it.Subtask && isEpic(it) && hasParent:
+			errs.add(iss, "an epic can't have a parent or an epic")
+		case !it.Subtask && iss.parent != nil && iss.parent.issueType != nil && !iss.parent.IsEpic():
+			errs.add(iss, "the parent %s must be an epic or the issue must be a sub-task", iss.parent.Pos)
+		}
+
+		provided := map[string]bool{"project": true, "issuetype": true, "summary": true}
+		if hasParent {
+			if it.Subtask {
+				provided["parent"] = true
+			} else if m.EpicLink != "" {
+				provided[m.EpicLink] = true
+			}
+		}
+		if isEpic(it) && m.EpicName != "" {
+			provided[m.EpicName] = true
+		}
+
+		for _, f := range iss.fields() {
+			if len(f.values) == 0 {
+				continue
+			}
+			provided[f.key] = true
+
+			meta, ok := it.Fields[f.key]
+			if !ok {
+				errs.add(iss, "%s can't be set for issue type %q", f.name, it.Name)
+				continue
+			}
+			if f.checkAllowed {
+				for _, v := range f.values {
+					if !isAllowed(meta, v) {
+						errs.add(iss, "invalid %s %q, allowed values are: %s", f.name, v, allowedValues(meta))
+					}
+				}
+			}
+		}
+
+		names := make([]string, 0, len(iss.CustomFields))
+		for name := range iss.CustomFields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			val := iss.CustomFields[name]
+			cf, ok := configured[name]
+			if !ok {
+				errs.add(iss, "custom field %q is not configured", name)
+				continue
+			}
+			meta, ok := it.Fields[cf.Key]
+			if !ok {
+				errs.add(iss, "custom field %q can't be set for issue type %q", name, it.Name)
+				continue
+			}
+			provided[cf.Key] = val != ""
+
+			if val == "" || len(meta.AllowedValues) == 0 {
+				continue
+			}
+			values := []string{val}
+			if cf.Schema.DataType == "array" {
+				values = splitValues(val)
+			}
+			for _, v := range values {
+				if !isAllowed(meta, v) {
+					errs.add(iss, "invalid value %q for custom field %q, allowed values are: %s", v, name, allowedValues(meta))
+				}
+			}
+		}
+
+		keys := make([]string, 0, len(it.Fields))
+		for k := range it.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			f := it.Fields[k]
+			if f.Required && !f.HasDefaultValue && !provided[k] {
+				errs.add(iss, "missing required field %q for issue type %q", f.Name, it.Name)
+			}
+		}
+	}
+
+	return errs.err()
+}
+
+func (m *Meta) issueType(name string) *jira.CreateMetaIssueType {
+	name = strings.TrimSpace(name)
+	for _, t := range m.IssueTypes {
+		if strings.EqualFold(t.Name, name) || (t.Handle != "" && strings.EqualFold(t.Handle, name)) {
+			return t
+		}
+	}
+	return nil
+}
+
+func (m *Meta) issueTypeNames() string {
+	names := make([]string, 0, len(m.IssueTypes))
+	for _, t := range m.IssueTypes {
+		names = append(names, t.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+type field struct {
+	name         string
+	key          string
+	values       []string
+	checkAllowed bool
+}
+
+func (i *Issue) fields() []field {
+	single := func(v string) []string {
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	}
+
+	return []field{
+		{name: "body", key: "description", values: single(i.Body)},
+		{name: "priority", key: "priority", values: single(i.Priority), checkAllowed: true},
+		{name: "assignee", key: "assignee", values: single(i.Assignee)},
+		{name: "reporter", key: "reporter", values: single(i.Reporter)},
+		{name: "labels", key: "labels", values: i.Labels},
+		{name: "component", key: "components", values: i.Components, checkAllowed: true},
+		{name: "fix version", key: "fixVersions", values: i.FixVersions, checkAllowed: true},
+		{name: "affects version", key: "versions", values: i.AffectsVersions, checkAllowed: true},
+		{name: "original estimate", key: "timetracking", values: single(i.OriginalEstimate)},
+	}
+}
+
+func isEpic(t *jira.CreateMetaIssueType) bool {
+	return t.Name == jira.IssueTypeEpic || t.Handle == jira.IssueTypeEpic
+}
+
+func isAllowed(f jira.IssueTypeField, val string) bool {
+	if len(f.AllowedValues) == 0 {
+		return true
+	}
+	for _, av := range f.AllowedValues {
+		if strings.EqualFold(av.Name, val) || strings.EqualFold(av.Value, val) {
+			return true
+		}
+	}
+	return false
+}
+
+func allowedValues(f jira.IssueTypeField) string {
+	values := make([]string, 0, len(f.AllowedValues))
+	for _, av := range f.AllowedValues {
+		v := av.Name
+		if v == "" {
+			v = av.Value
+		}
+		values = append(values, fmt.Sprintf("%q", v))
+	}
+	return strings.Join(values, ", ")
+}
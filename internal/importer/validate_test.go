@@ -0,0 +1,113 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func testMeta() *Meta {
+	priority := jira.IssueTypeField{
+		Name: "Priority", Key: "priority", HasDefaultValue: true,
+		AllowedValues: []*jira.FieldAllowedValue{{ID: "1", Name: "High"}, {ID: "2", Name: "Low"}},
+	}
+	storyPoints := jira.IssueTypeField{Name: "Story Points", Key: "customfield_10020"}
+	platforms := jira.IssueTypeField{
+		Name: "Platforms", Key: "customfield_10030",
+		AllowedValues: []*jira.FieldAllowedValue{{ID: "1", Value: "web"}, {ID: "2", Value: "ios"}},
+	}
+
+	common := func(extra ...jira.IssueTypeField) map[string]jira.IssueTypeField {
+		fields := map[string]jira.IssueTypeField{
+			"summary":     {Name: "Summary", Key: "summary", Required: true},
+			"issuetype":   {Name: "Issue Type", Key: "issuetype", Required: true},
+			"description": {Name: "Description", Key: "description"},
+			"labels":      {Name: "Labels", Key: "labels"},
+			"priority":    priority,
+		}
+		for _, f := range extra {
+			fields[f.Key] = f
+		}
+		return fields
+	}
+
+	platforms.Schema.DataType = "array"
+
+	return &Meta{
+		IssueTypes: []*jira.CreateMetaIssueType{
+			{
+				IssueType: jira.IssueType{ID: "1", Name: "Epic"},
+				Fields:    common(jira.IssueTypeField{Name: "Epic Name", Key: "customfield_10011", Required: true}),
+			},
+			{
+				IssueType: jira.IssueType{ID: "2", Name: "Story"},
+				Fields: common(
+					jira.IssueTypeField{Name: "Epic Link", Key: "customfield_10014"},
+					jira.IssueTypeField{Name: "Fix versions", Key: "fixVersions"},
+					storyPoints, platforms,
+				),
+			},
+			{
+				IssueType: jira.IssueType{ID: "3", Name: "Sub-task", Subtask: true},
+				Fields: common(
+					jira.IssueTypeField{Name: "Parent", Key: "parent", Required: true},
+					jira.IssueTypeField{Name: "Team", Key: "customfield_10040", Required: true},
+				),
+			},
+		},
+		CustomFields: []jira.IssueTypeField{storyPoints, platforms, {Name: "Team", Key: "customfield_10040"}},
+		EpicName:     "customfield_10011",
+		EpicLink:     "customfield_10014",
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	issues, err := Parse(strings.NewReader(csvIssues), FormatCSV)
+	assert.NoError(t, err)
+	issues[3].CustomFields = Values{"team": "Payments"}
+
+	planned, err := Plan(issues, "TEST")
+	assert.NoError(t, err)
+
+	assert.NoError(t, testMeta().Validate(planned))
+
+	assert.Equal(t, "Epic", planned[0].IssueType())
+	assert.Equal(t, "1", planned[0].IssueTypeID())
+	assert.True(t, planned[0].IsEpic())
+	assert.True(t, planned[3].IsSubtask())
+}
+
+func TestValidateErrors(t *testing.T) {
+	t.Parallel()
+
+	issues := []*Issue{
+		{Pos: "row 2", ID: "E1", Type: "epic", Summary: "Checkout", Priority: "Urgent"},
+		{Pos: "row 3", ID: "S1", Type: "Story", Summary: "Pay", Epic: "T1", Components: []string{"API"}},
+		{Pos: "row 4", ID: "T1", Type: "Sub-task", Summary: "Test", Parent: "E1"},
+		{Pos: "row 5", Type: "Sub-task", Summary: "Orphan", CustomFields: Values{"team": "QA"}},
+		{Pos: "row 6", Type: "Story", Summary: "Custom", CustomFields: Values{"platforms": "web,android", "size": "L", "team": "QA"}},
+		{Pos: "row 7", Type: "Bug", Summary: "Bug"},
+	}
+
+	planned, err := Plan(issues, "TEST")
+	assert.NoError(t, err)
+
+	err = testMeta().Validate(planned)
+	assert.EqualError(t, err, "invalid import file:\n"+
+		"  - row 7: unknown issue type \"Bug\", available types are: Epic, Story, Sub-task\n"+
+		"  - row 2: invalid priority \"Urgent\", allowed values are: \"High\", \"Low\"\n"+
+		"  - row 4: missing required field \"Team\" for issue type \"Sub-task\"\n"+
+		"  - row 3: the parent row 4 must be an epic or the issue must be a sub-task\n"+
+		"  - row 3: component can't be set for issue type \"Story\"\n"+
+		"  - row 5: parent is required for a sub-task\n"+
+		"  - row 5: missing required field \"Parent\" for issue type \"Sub-task\"\n"+
+		"  - row 6: invalid value \"android\" for custom field \"platforms\", allowed values are: \"web\", \"ios\"\n"+
+		"  - row 6: custom field \"size\" is not configured\n"+
+		"  - row 6: custom field \"team\" can't be set for issue type \"Story\"",
+	)
+}
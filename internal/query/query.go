@@ -9,3 +9,12 @@ type FlagParser interface {
 	GetUint(name string) (uint, error)
 	Set(name, value string) error
 }
+
+// Paginate parses the `paginate` flag and returns the start index and limit.
+func Paginate(flags FlagParser) (uint, uint, error) {
+	paginate, err := flags.GetString("paginate")
+	if err != nil {
+		return 0, 0, err
+	}
+	return getPaginateParams(paginate)
+}
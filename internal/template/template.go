@@ -0,0 +1,323 @@
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// Dir is the name of the templates directory inside the jira-cli config directory.
+const Dir = "templates"
+
+const frontMatterDelimiter = "---"
+
+var (
+	// Extensions are the supported template file extensions.
+	Extensions = []string{".md", ".yml", ".yaml", ".tmpl"}
+
+	placeholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_-]*)\s*\}\}`)
+)
+
+// Template is an issue template.
+//
+// A template is either a YAML file or a markdown file with a YAML front matter, in which
+// case the content after the front matter is the description of the issue. The fields can
+// contain {{var}} placeholders that are replaced with the values given when using it.
+// Templates without a front matter are plain description templates and are used as is.
+type Template struct {
+	Name         string            `yaml:"-"`
+	Path         string            `yaml:"-"`
+	Description  string            `yaml:"description"`
+	Type         string            `yaml:"type"`
+	Summary      string            `yaml:"summary"`
+	Priority     string            `yaml:"priority"`
+	Labels       []string          `yaml:"labels"`
+	Components   []string          `yaml:"components"`
+	CustomFields map[string]string `yaml:"custom"`
+	Subtasks     []*Subtask        `yaml:"subtasks"`
+	Vars         map[string]*Var   `yaml:"vars"`
+	Body         string            `yaml:"body"`
+
+	plain bool
+}
+
+// Subtask is a sub-task created along with the issue.
+type Subtask struct {
+	Summary string   `yaml:"summary"`
+	Body    string   `yaml:"body"`
+	Labels  []string `yaml:"labels"`
+}
+
+// Var describes a template variable.
+type Var struct {
+	Description string `yaml:"description"`
+	Default     string `yaml:"default"`
+}
+
+// Dirs returns the directories to look for the templates in the order of precedence,
+// ie: the issue.templates dir, if configured, and the templates dir in the config home.
+func Dirs() []string {
+	var dirs []string
+
+	if dir := viper.GetString("issue.templates"); dir != "" {
+		dirs = append(dirs, dir)
+	}
+	if home, err := cmdutil.GetConfigHome(); err == nil {
+		dirs = append(dirs, filepath.Join(home, jiraConfig.Dir, Dir))
+	}
+
+	return dirs
+}
+
+// Find returns the path to the template with the given name.
+func Find(name string) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+	for _, dir := range Dirs() {
+		for _, file := range candidates(name) {
+			path := filepath.Join(dir, file)
+			if info, err := os.Stat(path); err == nil && !info.IsDir() {
+				return path, true
+			}
+		}
+	}
+	return "", false
+}
+
+// List returns the templates in the template dirs sorted by name. If templates with the
+// same name exist in multiple dirs, the one in the dir with higher precedence is used.
+func List() ([]*Template, error) {
+	var (
+		out  []*Template
+		seen = make(map[string]bool)
+	)
+
+	for _, dir := range Dirs() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, e := range entries {
+			ext := filepath.Ext(e.Name())
+			if e.IsDir() || !slices.Contains(Extensions, ext) {
+				continue
+			}
+			name := strings.TrimSuffix(e.Name(), ext)
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			t, err := Load(filepath.Join(dir, e.Name()))
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, t)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+
+	return out, nil
+}
+
+// Load reads the template from the given file.
+func Load(path string) (*Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ext := filepath.Ext(path)
+
+	t, err := Parse(data, ext == ".yml" || ext == ".yaml")
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", path, err)
+	}
+	t.Name = strings.TrimSuffix(filepath.Base(path), ext)
+	t.Path = path
+
+	return t, nil
+}
+
+// Parse parses the template. The data is parsed as a YAML document if isYAML is
+// set, otherwise it is parsed as a markdown document with an optional front matter.
+func Parse(data []byte, isYAML bool) (*Template, error) {
+	var t Template
+
+	if isYAML {
+		if err := yaml.Unmarshal(data, &t); err != nil {
+			return nil, err
+		}
+		return &t, nil
+	}
+
+	meta, body, ok := splitFrontMatter(data)
+	if !ok {
+		return &Template{Body: string(data), plain: true}, nil
+	}
+	if err := yaml.Unmarshal(meta, &t); err != nil {
+		return nil, err
+	}
+	if t.Body == "" {
+		t.Body = string(body)
+	}
+
+	return &t, nil
+}
+
+// IsPlain checks if the template only contains the description, ie: it has no front matter.
+func (t *Template) IsPlain() bool {
+	return t.plain
+}
+
+// Variables returns the names of the variables used in the template in the order they appear.
+func (t *Template) Variables() []string {
+	var out []string
+
+	t.each(func(s string) string {
+		for _, m := range placeholder.FindAllStringSubmatch(s, -1) {
+			if !slices.Contains(out, m[1]) {
+				out = append(out, m[1])
+			}
+		}
+		return s
+	})
+
+	return out
+}
+
+// Render returns a copy of the template with the variables replaced with the given values.
+// The default values of the variables are used for the values that are not given.
+func (t *Template) Render(values map[string]string) (*Template, error) {
+	if t.plain {
+		return t, nil
+	}
+
+	for _, name := range t.Variables() {
+		if _, ok := values[name]; ok {
+			continue
+		}
+		if v, ok := t.Vars[name]; ok && v != nil && v.Default != "" {
+			continue
+		}
+		return nil, fmt.Errorf("missing value for the template variable %q", name)
+	}
+
+	out := t.clone()
+	out.each(func(s string) string {
+		return placeholder.ReplaceAllStringFunc(s, func(m string) string {
+			name := placeholder.FindStringSubmatch(m)[1]
+			if val, ok := values[name]; ok {
+				return val
+			}
+			return t.Vars[name].Default
+		})
+	})
+
+	return out, nil
+}
+
+// each applies fn to all the fields that can contain placeholders.
+func (t *Template) each(fn func(string) string) {
+	t.Type = fn(t.Type)
+	t.Summary = fn(t.Summary)
+	t.Priority = fn(t.Priority)
+	t.Body = fn(t.Body)
+
+	for i := range t.Labels {
+		t.Labels[i] = fn(t.Labels[i])
+	}
+	for i := range t.Components {
+		t.Components[i] = fn(t.Components[i])
+	}
+	// Iterate in a stable order so that the variables are reported consistently.
+	keys := make([]string, 0, len(t.CustomFields))
+	for k := range t.CustomFields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		t.CustomFields[k] = fn(t.CustomFields[k])
+	}
+	for _, st := range t.Subtasks {
+		st.Summary = fn(st.Summary)
+		st.Body = fn(st.Body)
+		for i := range st.Labels {
+			st.Labels[i] = fn(st.Labels[i])
+		}
+	}
+}
+
+func (t *Template) clone() *Template {
+	out := *t
+
+	out.Labels = slices.Clone(t.Labels)
+	out.Components = slices.Clone(t.Components)
+	if t.CustomFields != nil {
+		out.CustomFields = make(map[string]string, len(t.CustomFields))
+		for k, v := range t.CustomFields {
+			out.CustomFields[k] = v
+		}
+	}
+	out.Subtasks = make([]*Subtask, 0, len(t.Subtasks))
+	for _, st := range t.Subtasks {
+		c := *st
+		c.Labels = slices.Clone(st.Labels)
+		out.Subtasks = append(out.Subtasks, &c)
+	}
+
+	return &out
+}
+
+func candidates(name string) []string {
+	out := []string{name}
+	for _, ext := range Extensions {
+		out = append(out, name+ext)
+	}
+	return out
+}
+
+// splitFrontMatter splits the YAML front matter, ie: the block between the
+// two "---" lines at the beginning of the document, from the document body.
+func splitFrontMatter(data []byte) ([]byte, []byte, bool) {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	first, rest, ok := bytes.Cut(data, []byte("\n"))
+	if !ok || string(bytes.TrimSpace(first)) != frontMatterDelimiter {
+		return nil, nil, false
+	}
+
+	var meta []byte
+	for {
+		line, next, found := bytes.Cut(rest, []byte("\n"))
+		if string(bytes.TrimSpace(line)) == frontMatterDelimiter {
+			return meta, bytes.TrimLeft(next, "\r\n"), true
+		}
+		if !found {
+			return nil, nil, false
+		}
+		meta = append(meta, line...)
+		meta = append(meta, '\n')
+		rest = next
+	}
+}
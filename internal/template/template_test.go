@@ -0,0 +1,129 @@
+package template
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+const bugTemplate = `---
+description: Report a bug
+type: Bug
+summary: "[{{component}}] {{title}}"
+priority: High
+labels: [bug, "{{component}}"]
+components: ["{{component}}"]
+custom:
+  story-points: 3
+  environment: "{{env}}"
+subtasks:
+  - summary: "Reproduce {{title}}"
+  - summary: Write a regression test
+    labels: [qa]
+vars:
+  env:
+    description: Environment the bug was found in
+    default: production
+---
+## Steps to reproduce
+{{ steps }}
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := Parse([]byte(bugTemplate), false)
+	assert.NoError(t, err)
+	assert.False(t, tmpl.IsPlain())
+	assert.Equal(t, "Report a bug", tmpl.Description)
+	assert.Equal(t, "Bug", tmpl.Type)
+	assert.Equal(t, "[{{component}}] {{title}}", tmpl.Summary)
+	assert.Equal(t, "High", tmpl.Priority)
+	assert.Equal(t, []string{"bug", "{{component}}"}, tmpl.Labels)
+	assert.Equal(t, map[string]string{"story-points": "3", "environment": "{{env}}"}, tmpl.CustomFields)
+	assert.Len(t, tmpl.Subtasks, 2)
+	assert.Equal(t, "## Steps to reproduce\n{{ steps }}\n", tmpl.Body)
+	assert.Equal(t, []string{"component", "title", "steps", "env"}, tmpl.Variables())
+
+	tmpl, err = Parse([]byte("summary: Release {{version}}\nbody: |\n  Release notes\n"), true)
+	assert.NoError(t, err)
+	assert.Equal(t, "Release {{version}}", tmpl.Summary)
+	assert.Equal(t, "Release notes\n", tmpl.Body)
+
+	tmpl, err = Parse([]byte("## Plain description {{not-a-var}}\n---\n"), false)
+	assert.NoError(t, err)
+	assert.True(t, tmpl.IsPlain())
+	assert.Equal(t, "## Plain description {{not-a-var}}\n---\n", tmpl.Body)
+
+	// Unterminated front matter is treated as a plain template.
+	tmpl, err = Parse([]byte("---\ntype: Bug\n"), false)
+	assert.NoError(t, err)
+	assert.True(t, tmpl.IsPlain())
+
+	_, err = Parse([]byte("---\ntype: [Bug\n---\n"), false)
+	assert.Error(t, err)
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := Parse([]byte(bugTemplate), false)
+	assert.NoError(t, err)
+
+	_, err = tmpl.Render(map[string]string{"component": "API", "title": "Login fails"})
+	assert.EqualError(t, err, `missing value for the template variable "steps"`)
+
+	out, err := tmpl.Render(map[string]string{"component": "API", "title": "Login fails", "steps": "1. Log in"})
+	assert.NoError(t, err)
+	assert.Equal(t, "[API] Login fails", out.Summary)
+	assert.Equal(t, []string{"bug", "API"}, out.Labels)
+	assert.Equal(t, []string{"API"}, out.Components)
+	assert.Equal(t, map[string]string{"story-points": "3", "environment": "production"}, out.CustomFields)
+	assert.Equal(t, "Reproduce Login fails", out.Subtasks[0].Summary)
+	assert.Equal(t, []string{"qa"}, out.Subtasks[1].Labels)
+	assert.Equal(t, "## Steps to reproduce\n1. Log in\n", out.Body)
+
+	// The template itself is not modified.
+	assert.Equal(t, "[{{component}}] {{title}}", tmpl.Summary)
+	assert.Equal(t, "Reproduce {{title}}", tmpl.Subtasks[0].Summary)
+	assert.Equal(t, "{{env}}", tmpl.CustomFields["environment"])
+}
+
+func TestFindAndList(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", home)
+
+	local := t.TempDir()
+	viper.Set("issue.templates", local)
+	t.Cleanup(viper.Reset)
+
+	global := filepath.Join(home, ".jira", Dir)
+	assert.NoError(t, os.MkdirAll(global, 0o755))
+
+	assert.NoError(t, os.WriteFile(filepath.Join(global, "bug.md"), []byte(bugTemplate), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(global, "story.md"), []byte("As a user"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(global, "notes.txt"), []byte("ignored"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(local, "bug.yml"), []byte("type: Bug\nsummary: Local bug\n"), 0o600))
+
+	path, ok := Find("bug")
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(local, "bug.yml"), path)
+
+	path, ok = Find("story.md")
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(global, "story.md"), path)
+
+	_, ok = Find("notes")
+	assert.False(t, ok)
+
+	list, err := List()
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, "bug", list[0].Name)
+	assert.Equal(t, "Local bug", list[0].Summary)
+	assert.Equal(t, "story", list[1].Name)
+	assert.True(t, list[1].IsPlain())
+}
@@ -0,0 +1,94 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// AttachmentOption is a functional option to wrap attachment properties.
+type AttachmentOption func(*Attachment)
+
+// Attachment is an issue attachment view.
+type Attachment struct {
+	data   []*jira.Attachment
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewAttachment initializes an attachment view.
+func NewAttachment(data []*jira.Attachment, opts ...AttachmentOption) *Attachment {
+	a := Attachment{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	a.writer = tabwriter.NewWriter(a.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&a)
+	}
+	return &a
+}
+
+// WithAttachmentWriter sets a writer for the attachment view.
+func WithAttachmentWriter(w io.Writer) AttachmentOption {
+	return func(a *Attachment) {
+		a.writer = w
+	}
+}
+
+// Render renders the attachment view.
+func (a Attachment) Render() error {
+	a.printHeader()
+
+	for _, d := range a.data {
+		_, _ = fmt.Fprintf(
+			a.writer, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			d.ID, prepareTitle(d.Filename), formatBytes(d.Size), d.MimeType, authorName(d.Author),
+			cmdutil.FormatDateTimeHuman(d.Created, jira.RFC3339),
+		)
+	}
+	if _, ok := a.writer.(*tabwriter.Writer); ok {
+		err := a.writer.(*tabwriter.Writer).Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tui.PagerOut(a.buf.String())
+}
+
+func (a Attachment) header() []string {
+	return []string{
+		"ID",
+		"FILENAME",
+		"SIZE",
+		"MIME TYPE",
+		"AUTHOR",
+		"CREATED",
+	}
+}
+
+func (a Attachment) printHeader() {
+	headers := a.header()
+	end := len(headers) - 1
+	for i, h := range headers {
+		_, _ = fmt.Fprintf(a.writer, "%s", h)
+		if i != end {
+			_, _ = fmt.Fprintf(a.writer, "\t")
+		}
+	}
+	_, _ = fmt.Fprintln(a.writer)
+}
+
+func authorName(u jira.User) string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	return u.Name
+}
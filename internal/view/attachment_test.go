@@ -0,0 +1,41 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestAttachmentRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Attachment{
+		{
+			ID:       "10000",
+			Filename: "app.log",
+			Author:   jira.User{DisplayName: "Person A"},
+			Created:  "2020-12-03T14:05:20.974+0100",
+			Size:     2048,
+			MimeType: "text/plain",
+		},
+		{
+			ID:       "10001",
+			Filename: "screenshot.png",
+			Author:   jira.User{Name: "person-b"},
+			Created:  "2020-12-04T10:15:00.000+0100",
+			Size:     1048576,
+			MimeType: "image/png",
+		},
+	}
+	attachment := NewAttachment(data, WithAttachmentWriter(&b))
+	assert.NoError(t, attachment.Render())
+
+	expected := `ID	FILENAME	SIZE	MIME TYPE	AUTHOR	CREATED
+10000	app.log	2.0 KB	text/plain	Person A	Thu, 03 Dec 20
+10001	screenshot.png	1.0 MB	image/png	person-b	Fri, 04 Dec 20
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -0,0 +1,260 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// Bulk actions available in the issue list.
+const (
+	bulkActionTransition = "Transition"
+	bulkActionAssign     = "Assign"
+	bulkActionLabel      = "Label"
+	bulkActionSprint     = "Sprint"
+	bulkActionDelete     = "Delete"
+)
+
+// issueBulkActions returns the actions that can be applied to the marked issues in the issue list.
+// The handlers only read the table data, the updates they return are applied on the UI goroutine.
+func issueBulkActions(client *jira.Client, project string, data tui.TableData) []tui.BulkAction {
+	var (
+		keyIdx = data.GetIndex(fieldKey)
+
+		// Transitions and assignees are cached across the actions that may run concurrently.
+		mu          sync.Mutex
+		transitions = make(map[string][]*jira.Transition)
+		assignees   = make(map[string]*jira.User)
+	)
+
+	key := func(r int) string {
+		return data.Get(r, keyIdx)
+	}
+	update := func(r int, column, val string) func() {
+		return func() {
+			if c := data.GetIndex(column); c != -1 {
+				data.Update(r, c, val)
+			}
+		}
+	}
+	getTransitions := func(k string) ([]*jira.Transition, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		tr, ok := transitions[k]
+		return tr, ok
+	}
+	setTransitions := func(k string, tr []*jira.Transition) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if tr == nil {
+			delete(transitions, k)
+			return
+		}
+		transitions[k] = tr
+	}
+
+	return []tui.BulkAction{
+		{
+			Name: bulkActionTransition,
+			Options: func(rows []int) ([]string, error) {
+				var (
+					actions []string
+					seen    = make(map[string]struct{})
+				)
+				for _, r := range rows {
+					k := key(r)
+					tr, ok := getTransitions(k)
+					if !ok {
+						var err error
+						if tr, err = api.ProxyTransitions(client, k); err != nil {
+							return nil, fmt.Errorf("%s: %w", k, err)
+						}
+						setTransitions(k, tr)
+					}
+					for _, t := range tr {
+						if _, ok := seen[t.Name]; !ok {
+							seen[t.Name] = struct{}{}
+							actions = append(actions, t.Name)
+						}
+					}
+				}
+				return actions, nil
+			},
+			Handler: func(r int, state string) (func(), error) {
+				k := key(r)
+				available, _ := getTransitions(k)
+
+				var tr *jira.Transition
+				for _, t := range available {
+					if strings.EqualFold(t.Name, state) {
+						tr = t
+						break
+					}
+				}
+				if tr == nil {
+					return nil, fmt.Errorf("%s: transition '%s' not available", k, state)
+				}
+				_, err := client.Transition(k, &jira.TransitionRequest{
+					Transition: &jira.TransitionRequestData{
+						ID:   tr.ID.String(),
+						Name: tr.Name,
+					},
+				})
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+
+				// Available transitions change once the issue is transitioned.
+				setTransitions(k, nil)
+
+				status := tr.To.Name
+				if status == "" {
+					status = tr.Name
+				}
+				return update(r, fieldStatus, status), nil
+			},
+		},
+		{
+			Name:  bulkActionAssign,
+			Input: "Assignee (email or name, x to unassign)",
+			Handler: func(r int, assignee string) (func(), error) {
+				k := key(r)
+
+				if strings.EqualFold(assignee, "x") {
+					if err := api.ProxyAssignIssue(client, k, nil, jira.AssigneeNone); err != nil {
+						return nil, fmt.Errorf("%s: %w", k, err)
+					}
+					return update(r, fieldAssignee, ""), nil
+				}
+
+				mu.Lock()
+				user, ok := assignees[assignee]
+				mu.Unlock()
+
+				if !ok {
+					u, err := findAssignee(client, project, assignee)
+					if err != nil {
+						return nil, fmt.Errorf("%s: %w", k, err)
+					}
+					mu.Lock()
+					assignees[assignee], user = u, u
+					mu.Unlock()
+				}
+				if err := api.ProxyAssignIssue(client, k, user, ""); err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+				return update(r, fieldAssignee, user.DisplayName), nil
+			},
+		},
+		{
+			Name:  bulkActionLabel,
+			Input: "Labels (comma separated, prefix with - to remove)",
+			Handler: func(r int, value string) (func(), error) {
+				k := key(r)
+
+				var labels []string
+				for _, l := range strings.Split(value, ",") {
+					if l = strings.TrimSpace(l); l != "" {
+						labels = append(labels, l)
+					}
+				}
+				if err := api.ProxyEdit(client, k, &jira.EditRequest{Labels: labels}); err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+
+				return func() {
+					if c := data.GetIndex(fieldLabels); c != -1 {
+						data.Update(r, c, mergeLabels(data.Get(r, c), labels))
+					}
+				}, nil
+			},
+		},
+		{
+			Name:  bulkActionSprint,
+			Input: "Sprint ID",
+			Handler: func(r int, sprint string) (func(), error) {
+				k := key(r)
+				if err := client.SprintIssuesAdd(sprint, k); err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+				return nil, nil
+			},
+		},
+		{
+			Name:        bulkActionDelete,
+			Confirm:     true,
+			Destructive: true,
+			Handler: func(r int, _ string) (func(), error) {
+				k := key(r)
+				if err := client.DeleteIssue(k, false); err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+				return nil, nil
+			},
+		},
+	}
+}
+
+// findAssignee looks for an assignable user in the project by their email or name.
+func findAssignee(client *jira.Client, project, assignee string) (*jira.User, error) {
+	users, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
+		Query:   assignee,
+		Project: project,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range users {
+		if strings.EqualFold(u.Email, assignee) || strings.EqualFold(u.DisplayName, assignee) || strings.EqualFold(u.Name, assignee) {
+			return u, nil
+		}
+	}
+	if len(users) == 1 {
+		return users[0], nil
+	}
+	if len(users) > 1 {
+		return nil, fmt.Errorf("multiple users found for %q, use the email of the user instead", assignee)
+	}
+	return nil, fmt.Errorf("invalid assignee %q", assignee)
+}
+
+// mergeLabels applies the labels to add or remove, ie: prefixed with '-', to the comma separated labels.
+func mergeLabels(current string, labels []string) string {
+	var out []string
+	if current != "" {
+		out = strings.Split(current, ",")
+	}
+
+	for _, l := range labels {
+		if strings.HasPrefix(l, "-") {
+			l = strings.TrimPrefix(l, "-")
+			for i, c := range out {
+				if c == l {
+					out = append(out[:i], out[i+1:]...)
+					break
+				}
+			}
+			continue
+		}
+
+		exists := false
+		for _, c := range out {
+			if c == l {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			out = append(out, l)
+		}
+	}
+
+	return strings.Join(out, ",")
+}
@@ -0,0 +1,46 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeLabels(t *testing.T) {
+	cases := []struct {
+		name     string
+		current  string
+		labels   []string
+		expected string
+	}{
+		{
+			name:     "add to empty",
+			labels:   []string{"backend", "urgent"},
+			expected: "backend,urgent",
+		},
+		{
+			name:     "add existing",
+			current:  "backend",
+			labels:   []string{"backend", "urgent"},
+			expected: "backend,urgent",
+		},
+		{
+			name:     "add and remove",
+			current:  "backend,frontend,urgent",
+			labels:   []string{"-frontend", "api", "-missing"},
+			expected: "backend,urgent,api",
+		},
+		{
+			name:     "remove all",
+			current:  "backend",
+			labels:   []string{"-backend"},
+			expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mergeLabels(tc.current, tc.labels))
+		})
+	}
+}
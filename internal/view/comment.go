@@ -0,0 +1,140 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const maxCommentBodyLength = 80
+
+// CommentList is a list view for issue comments.
+type CommentList struct {
+	Issue   string
+	Server  string
+	Total   int
+	Data    []*jira.Comment
+	Display DisplayFormat
+}
+
+// Render renders the comment list view.
+func (cl CommentList) Render() error {
+	if cl.Display.CSV {
+		return cl.renderCSV(os.Stdout)
+	}
+
+	if cl.Display.Plain || tui.IsDumbTerminal() || tui.IsNotTTY() {
+		// custom delimiter is used only in plain mode, otherwise \t is used
+		delimiter := "\t"
+		if cl.Display.Plain {
+			delimiter = cl.Display.Delimiter
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
+		return cl.renderPlain(w, delimiter)
+	}
+
+	r, err := MDRenderer()
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	for _, c := range cl.Data {
+		meta := fmt.Sprintf(
+			"\n %s • %s • %s",
+			coloredOut(c.ID, color.FgGreen, color.Bold),
+			coloredOut(authorName(c.Author), color.FgWhite, color.Bold),
+			coloredOut(cmdutil.FormatDateTimeHuman(c.Created, jira.RFC3339), color.FgWhite, color.Bold),
+		)
+		if c.Updated != "" && c.Updated != c.Created {
+			meta += fmt.Sprintf(" • %s", gray("edited"))
+		}
+		body, err := r.Render(md.FromJiraMD(c.Body))
+		if err != nil {
+			return err
+		}
+		out.WriteString(meta + "\n" + body)
+	}
+	out.WriteString(cl.footer())
+
+	return tui.PagerOut(out.String())
+}
+
+// renderPlain renders comments in plain view.
+func (cl CommentList) renderPlain(w io.Writer, delimiter string) error {
+	return renderPlain(w, cl.data(), delimiter)
+}
+
+// renderCSV renders comments in csv format.
+func (cl CommentList) renderCSV(w io.Writer) error {
+	return renderCSV(w, cl.data())
+}
+
+func (cl CommentList) header() []string {
+	return []string{
+		"ID",
+		"AUTHOR",
+		"CREATED",
+		"UPDATED",
+		"BODY",
+	}
+}
+
+func (cl CommentList) data() tui.TableData {
+	var data tui.TableData
+
+	if !cl.Display.NoHeaders {
+		data = append(data, cl.header())
+	}
+	for _, c := range cl.Data {
+		data = append(data, []string{
+			c.ID,
+			authorName(c.Author),
+			formatDateTime(c.Created, jira.RFC3339, cl.Display.Timezone),
+			formatDateTime(c.Updated, jira.RFC3339, cl.Display.Timezone),
+			cl.body(c.Body),
+		})
+	}
+
+	return data
+}
+
+func (cl CommentList) body(b string) string {
+	// CSV can hold multi-line values, so we will keep the body as is.
+	if cl.Display.CSV {
+		return b
+	}
+
+	b = strings.Join(strings.Fields(b), " ")
+	if cl.Display.NoTruncate {
+		return b
+	}
+	return strings.TrimSpace(shortenAndPad(b, maxCommentBodyLength))
+}
+
+func (cl CommentList) footer() string {
+	var out strings.Builder
+
+	if cl.Total > len(cl.Data) {
+		out.WriteString(
+			fmt.Sprintf("\n%s\n", gray(fmt.Sprintf(
+				"Showing %d of %d comments. Use --paginate <from>:<limit> to load more comments",
+				len(cl.Data), cl.Total,
+			))),
+		)
+	}
+	out.WriteString(
+		fmt.Sprintf("\n%s\n", gray(fmt.Sprintf("View this issue on Jira: %s", cmdutil.GenerateServerBrowseURL(cl.Server, cl.Issue)))),
+	)
+
+	return out.String()
+}
@@ -0,0 +1,84 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func getTestComments() []*jira.Comment {
+	return []*jira.Comment{
+		{
+			ID:      "10100",
+			Author:  jira.User{DisplayName: "Person A"},
+			Body:    "First comment",
+			Created: "2020-12-03T14:05:20.974+0100",
+			Updated: "2020-12-03T14:05:20.974+0100",
+		},
+		{
+			ID:      "10101",
+			Author:  jira.User{Name: "person-b"},
+			Body:    "Second comment\n\nspanning multiple lines",
+			Created: "2020-12-04T10:15:00.000+0100",
+			Updated: "2020-12-05T09:00:00.000+0100",
+		},
+	}
+}
+
+func TestCommentListRenderInPlainView(t *testing.T) {
+	var b bytes.Buffer
+
+	comments := CommentList{
+		Issue:   "TEST-1",
+		Data:    getTestComments(),
+		Display: DisplayFormat{Plain: true, Delimiter: "|", Timezone: "UTC"},
+	}
+	assert.NoError(t, comments.renderPlain(&b, "|"))
+
+	expected := `ID|AUTHOR|CREATED|UPDATED|BODY
+10100|Person A|2020-12-03 13:05:20|2020-12-03 13:05:20|First comment
+10101|person-b|2020-12-04 09:15:00|2020-12-05 08:00:00|Second comment spanning multiple lines
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestCommentListRenderInPlainViewWithoutHeaders(t *testing.T) {
+	var b bytes.Buffer
+
+	data := getTestComments()
+	data[1].Body = "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore"
+
+	comments := CommentList{
+		Issue:   "TEST-1",
+		Data:    data,
+		Display: DisplayFormat{Plain: true, NoHeaders: true, Timezone: "UTC"},
+	}
+	assert.NoError(t, comments.renderPlain(&b, "|"))
+
+	expected := `10100|Person A|2020-12-03 13:05:20|2020-12-03 13:05:20|First comment
+10101|person-b|2020-12-04 09:15:00|2020-12-05 08:00:00|Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor …
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestCommentListRenderInCSV(t *testing.T) {
+	var b bytes.Buffer
+
+	comments := CommentList{
+		Issue:   "TEST-1",
+		Data:    getTestComments(),
+		Display: DisplayFormat{CSV: true, Timezone: "UTC"},
+	}
+	assert.NoError(t, comments.renderCSV(&b))
+
+	expected := `ID,AUTHOR,CREATED,UPDATED,BODY
+10100,Person A,2020-12-03 13:05:20,2020-12-03 13:05:20,First comment
+10101,person-b,2020-12-04 09:15:00,2020-12-05 08:00:00,"Second comment
+
+spanning multiple lines"
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -0,0 +1,59 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// ContextOption is a functional option to wrap context list properties.
+type ContextOption func(*ContextList)
+
+// ContextList is a list view for config contexts.
+type ContextList struct {
+	data   []*config.Context
+	writer io.Writer
+}
+
+// NewContextList constructs a context list view.
+func NewContextList(data []*config.Context, opts ...ContextOption) *ContextList {
+	c := ContextList{
+		data:   data,
+		writer: os.Stdout,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &c
+}
+
+// WithContextWriter sets a writer for the context list.
+func WithContextWriter(w io.Writer) ContextOption {
+	return func(c *ContextList) {
+		c.writer = w
+	}
+}
+
+// Render renders the context list view. The active context is marked with an asterisk.
+func (c ContextList) Render() error {
+	w := tabwriter.NewWriter(c.writer, 0, tabWidth, 1, '\t', 0)
+
+	_, _ = fmt.Fprintln(w, "CURRENT\tNAME\tINSTALLATION\tSERVER\tLOGIN\tPROJECT")
+
+	for _, d := range c.data {
+		current := ""
+		if d.Active {
+			current = "*"
+		}
+		_, _ = fmt.Fprintf(
+			w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			current, d.Name, d.Installation, d.Server, d.Login, d.Project,
+		)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,25 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+func TestContextListRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*config.Context{
+		{Name: "cloud", Installation: "Cloud", Server: "https://example.atlassian.net", Login: "jon@example.com", Project: "ABC"},
+		{Name: "onprem", Installation: "Local", Server: "https://jira.example.com", Login: "jon", Active: true},
+	}
+	assert.NoError(t, NewContextList(data, WithContextWriter(&b)).Render())
+
+	expected := "CURRENT\tNAME\tINSTALLATION\tSERVER\t\t\t\tLOGIN\t\tPROJECT\n" +
+		"\tcloud\tCloud\t\thttps://example.atlassian.net\tjon@example.com\tABC\n" +
+		"*\tonprem\tLocal\t\thttps://jira.example.com\tjon\t\t\n"
+	assert.Equal(t, expected, b.String())
+}
@@ -2,6 +2,7 @@ package view
 
 import (
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -16,8 +17,10 @@ import (
 	"github.com/mgutz/ansi"
 	"github.com/rivo/tview"
 
+	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/pkg/browser"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
 
@@ -52,6 +55,10 @@ const (
 * [yellow]CTRL + b[default] to scroll through a page upwards
 * [yellow]v[default] to view selected issue details
 * [yellow]m[default] to move/transition selected issue
+* [yellow]SPACE[default] to select or unselect an issue for bulk actions
+* [yellow]SHIFT + ↑ ↓[default] to select multiple issues at once
+* [yellow]b[default] to transition, assign, label, add to sprint or delete the selected issues
+* [yellow]s[default] to sort the issues by a column
 * [yellow]CTRL + r / F5[default] to refresh the issues list
 * [yellow]ENTER[default] to open the selected issue in the browser
 * [yellow]c[default] to copy issue URL to the system clipboard
@@ -112,6 +119,36 @@ func formatDateTime(dt, format, tz string) string {
 	return t.In(loc).Format("2006-01-02 15:04:05")
 }
 
+// formatCustomField formats the raw value of a custom field based on its schema type.
+func formatCustomField(cf *jira.IssueTypeField, raw json.RawMessage, tz string) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	switch cf.Schema.DataType {
+	case "datetime":
+		var dt string
+		if err := json.Unmarshal(raw, &dt); err != nil {
+			break
+		}
+		return formatDateTime(dt, jira.RFC3339, tz)
+	case "array":
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			break
+		}
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			if v := jira.CustomFieldValue(item); v != "" {
+				values = append(values, v)
+			}
+		}
+		return strings.Join(values, ",")
+	}
+
+	return jira.CustomFieldValue(raw)
+}
+
 func prepareTitle(text string) string {
 	text = strings.TrimSpace(text)
 	return tview.Escape(text)
@@ -125,6 +162,8 @@ func issueKeyFromTuiData(r int, d any) string {
 		path = data.Get(r, data.GetIndex(fieldKey))
 	case tui.PreviewData:
 		path = data.Key
+	case tui.KanbanCard:
+		path = data.Key
 	}
 
 	return path
@@ -152,6 +191,40 @@ func copyKey() tui.CopyKeyFunc {
 	}
 }
 
+// issueTransitions fetches the transitions available for an issue. It returns the
+// transition names along with a func that transitions the issue to the given state.
+func issueTransitions(key string) ([]string, func(state string) (*jira.Transition, error)) {
+	client := api.DefaultClient(false)
+	transitions, _ := api.ProxyTransitions(client, key)
+
+	var actions []string
+	for _, t := range transitions {
+		actions = append(actions, t.Name)
+	}
+
+	transition := func(state string) (*jira.Transition, error) {
+		var tr *jira.Transition
+		for _, t := range transitions {
+			if strings.EqualFold(t.Name, state) {
+				tr = t
+				break
+			}
+		}
+		if tr == nil {
+			return nil, fmt.Errorf("transition '%s' not found", state)
+		}
+		_, err := client.Transition(key, &jira.TransitionRequest{
+			Transition: &jira.TransitionRequestData{
+				ID:   tr.ID.String(),
+				Name: tr.Name,
+			},
+		})
+		return tr, err
+	}
+
+	return actions, transition
+}
+
 func renderPlain(w io.Writer, data tui.TableData, delimiter string) error {
 	for _, items := range data {
 		n := len(items)
@@ -228,6 +301,22 @@ func pad(msg string, limit int) string {
 	return out.String()
 }
 
+// formatBytes formats given size in a human readable format, eg: 1.5 MB.
+func formatBytes(size int64) string {
+	const unit = 1024
+
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
 func max(a, b int) int {
 	if a > b {
 		return a
@@ -2,6 +2,7 @@
 package view
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -107,6 +108,79 @@ func TestPrepareTitle(t *testing.T) {
 	}
 }
 
+func TestFormatCustomField(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		dataType string
+		raw      string
+		expected string
+	}{
+		{
+			name:     "it returns empty string if value is not set",
+			dataType: "number",
+			raw:      "",
+			expected: "",
+		},
+		{
+			name:     "it returns empty string for null value",
+			dataType: "string",
+			raw:      "null",
+			expected: "",
+		},
+		{
+			name:     "it formats number",
+			dataType: "number",
+			raw:      "3.5",
+			expected: "3.5",
+		},
+		{
+			name:     "it formats date",
+			dataType: "date",
+			raw:      `"2022-03-04"`,
+			expected: "2022-03-04",
+		},
+		{
+			name:     "it formats datetime",
+			dataType: "datetime",
+			raw:      `"2020-12-13T14:05:20.974+0100"`,
+			expected: "2020-12-13 14:05:20",
+		},
+		{
+			name:     "it formats user",
+			dataType: "user",
+			raw:      `{"accountId": "a123", "displayName": "Person A"}`,
+			expected: "Person A",
+		},
+		{
+			name:     "it formats option",
+			dataType: "option",
+			raw:      `{"id": "10001", "value": "Mobile"}`,
+			expected: "Mobile",
+		},
+		{
+			name:     "it formats array",
+			dataType: "array",
+			raw:      `[{"value": "iOS"}, {"value": "Android"}]`,
+			expected: "iOS,Android",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cf := jira.IssueTypeField{Name: "Field", Key: "customfield_10001"}
+			cf.Schema.DataType = tc.dataType
+
+			assert.Equal(t, tc.expected, formatCustomField(&cf, json.RawMessage(tc.raw), ""))
+		})
+	}
+}
+
 func TestShortenAndPad(t *testing.T) {
 	t.Parallel()
 
@@ -194,3 +268,44 @@ func TestMax(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatBytes(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    int64
+		expected string
+	}{
+		{
+			name:     "it formats bytes",
+			input:    512,
+			expected: "512 B",
+		},
+		{
+			name:     "it formats kilobytes",
+			input:    2048,
+			expected: "2.0 KB",
+		},
+		{
+			name:     "it formats megabytes",
+			input:    1572864,
+			expected: "1.5 MB",
+		},
+		{
+			name:     "it formats gigabytes",
+			input:    3221225472,
+			expected: "3.0 GB",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, formatBytes(tc.input))
+		})
+	}
+}
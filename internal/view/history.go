@@ -0,0 +1,99 @@
+package view
+
+import (
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const maxHistoryValueLength = 40
+
+// IssueHistory is a list view for the change history of an issue.
+type IssueHistory struct {
+	Issue   string
+	Data    []*jira.ChangelogHistory
+	Display DisplayFormat
+}
+
+// Render renders the issue history view.
+func (ih IssueHistory) Render() error {
+	if ih.Display.CSV {
+		return ih.renderCSV(os.Stdout)
+	}
+
+	// custom delimiter is used only in plain mode, otherwise \t is used
+	delimiter := "\t"
+	if ih.Display.Plain {
+		delimiter = ih.Display.Delimiter
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
+
+	return ih.renderPlain(w, delimiter)
+}
+
+// renderPlain renders the history in plain view.
+func (ih IssueHistory) renderPlain(w io.Writer, delimiter string) error {
+	return renderPlain(w, ih.data(), delimiter)
+}
+
+// renderCSV renders the history in csv format.
+func (ih IssueHistory) renderCSV(w io.Writer) error {
+	return renderCSV(w, ih.data())
+}
+
+func (ih IssueHistory) header() []string {
+	return []string{
+		"DATE",
+		"AUTHOR",
+		"FIELD",
+		"FROM",
+		"TO",
+	}
+}
+
+// data flattens the history so that each field change is in its own row.
+func (ih IssueHistory) data() tui.TableData {
+	var data tui.TableData
+
+	if !ih.Display.NoHeaders {
+		data = append(data, ih.header())
+	}
+	for _, h := range ih.Data {
+		created := formatDateTime(h.Created, jira.RFC3339, ih.Display.Timezone)
+
+		for _, item := range h.Items {
+			data = append(data, []string{
+				created,
+				authorName(h.Author),
+				item.Field,
+				ih.value(item.FromString, item.From),
+				ih.value(item.ToString, item.To),
+			})
+		}
+	}
+
+	return data
+}
+
+// value returns the human-readable value of a change, falling back to the raw value.
+func (ih IssueHistory) value(str, raw string) string {
+	v := str
+	if v == "" {
+		v = raw
+	}
+
+	// CSV can hold multi-line values, so we will keep the value as is.
+	if ih.Display.CSV {
+		return v
+	}
+
+	v = strings.Join(strings.Fields(v), " ")
+	if ih.Display.NoTruncate {
+		return v
+	}
+	return strings.TrimSpace(shortenAndPad(v, maxHistoryValueLength))
+}
@@ -0,0 +1,69 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func getTestHistory() []*jira.ChangelogHistory {
+	return []*jira.ChangelogHistory{
+		{
+			ID:      "10101",
+			Author:  jira.User{DisplayName: "Person A"},
+			Created: "2022-01-03T09:30:00.000+0100",
+			Items: []*jira.ChangelogItem{
+				{Field: "status", From: "10000", FromString: "To Do", To: "3", ToString: "In Progress"},
+				{Field: "assignee", To: "a-b-c", ToString: "Person A"},
+			},
+		},
+		{
+			ID:      "10102",
+			Author:  jira.User{Name: "person-b"},
+			Created: "2022-01-04T15:00:00.000+0100",
+			Items: []*jira.ChangelogItem{
+				{Field: "description", FromString: "Old\ndescription", ToString: "New\ndescription"},
+			},
+		},
+	}
+}
+
+func TestIssueHistoryRenderInPlainView(t *testing.T) {
+	var b bytes.Buffer
+
+	history := IssueHistory{
+		Issue:   "TEST-1",
+		Data:    getTestHistory(),
+		Display: DisplayFormat{Plain: true, Timezone: "UTC"},
+	}
+	assert.NoError(t, history.renderPlain(&b, "|"))
+
+	expected := `DATE|AUTHOR|FIELD|FROM|TO
+2022-01-03 08:30:00|Person A|status|To Do|In Progress
+2022-01-03 08:30:00|Person A|assignee||Person A
+2022-01-04 14:00:00|person-b|description|Old description|New description
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestIssueHistoryRenderInCSV(t *testing.T) {
+	var b bytes.Buffer
+
+	history := IssueHistory{
+		Issue:   "TEST-1",
+		Data:    getTestHistory(),
+		Display: DisplayFormat{CSV: true, NoHeaders: true, Timezone: "UTC"},
+	}
+	assert.NoError(t, history.renderCSV(&b))
+
+	expected := `2022-01-03 08:30:00,Person A,status,To Do,In Progress
+2022-01-03 08:30:00,Person A,assignee,,Person A
+2022-01-04 14:00:00,person-b,description,"Old
+description","New
+description"
+`
+	assert.Equal(t, expected, b.String())
+}
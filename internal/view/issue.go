@@ -109,6 +109,15 @@ func (i Issue) String() string {
 	if len(i.Data.Fields.IssueLinks) > 0 {
 		s.WriteString(fmt.Sprintf("\n\n%s\n\n%s\n", i.separator("Linked Issues"), i.linkedIssues()))
 	}
+	if len(i.Data.Fields.Attachments) > 0 {
+		s.WriteString(
+			fmt.Sprintf(
+				"\n\n%s\n\n%s\n",
+				i.separator(fmt.Sprintf("%d Attachments", len(i.Data.Fields.Attachments))),
+				i.attachments(),
+			),
+		)
+	}
 	total := i.Data.Fields.Comment.Total
 	if total > 0 && i.Options.NumComments > 0 {
 		sep := fmt.Sprintf("%d Comments", total)
@@ -160,6 +169,17 @@ func (i Issue) fragments() []fragment {
 		)
 	}
 
+	if len(i.Data.Fields.Attachments) > 0 {
+		scraps = append(
+			scraps,
+			newBlankFragment(1),
+			fragment{Body: i.separator(fmt.Sprintf("%d Attachments", len(i.Data.Fields.Attachments)))},
+			newBlankFragment(2),
+			fragment{Body: i.attachments()},
+			newBlankFragment(1),
+		)
+	}
+
 	if i.Data.Fields.Comment.Total > 0 && i.Options.NumComments > 0 {
 		scraps = append(
 			scraps,
@@ -378,6 +398,45 @@ func (i Issue) linkedIssues() string {
 	return linked.String()
 }
 
+func (i Issue) attachments() string {
+	if len(i.Data.Fields.Attachments) == 0 {
+		return ""
+	}
+
+	var (
+		attachments    strings.Builder
+		maxIDLen       int
+		maxFilenameLen int
+		maxSizeLen     int
+		maxAuthorLen   int
+	)
+
+	for _, a := range i.Data.Fields.Attachments {
+		maxIDLen = max(len(a.ID), maxIDLen)
+		maxFilenameLen = max(len(a.Filename), maxFilenameLen)
+		maxSizeLen = max(len(formatBytes(a.Size)), maxSizeLen)
+		maxAuthorLen = max(len(authorName(a.Author)), maxAuthorLen)
+	}
+
+	attachments.WriteString(
+		fmt.Sprintf("\n %s\n\n", coloredOut("ATTACHMENTS", color.FgWhite, color.Bold)),
+	)
+	for _, a := range i.Data.Fields.Attachments {
+		attachments.WriteString(
+			fmt.Sprintf(
+				"  %s %s • %s • %s • %s\n",
+				coloredOut(pad(a.ID, maxIDLen), color.FgGreen, color.Bold),
+				shortenAndPad(a.Filename, min(maxFilenameLen, defaultSummaryLength)),
+				pad(formatBytes(a.Size), maxSizeLen),
+				pad(authorName(a.Author), maxAuthorLen),
+				cmdutil.FormatDateTimeHuman(a.Created, jira.RFC3339),
+			),
+		)
+	}
+
+	return attachments.String()
+}
+
 func (i Issue) comments() []issueComment {
 	total := i.Data.Fields.Comment.Total
 	comments := make([]issueComment, 0, total)
@@ -25,6 +25,7 @@ type DisplayFormat struct {
 	Comments     uint
 	TableStyle   tui.TableStyle
 	Timezone     string
+	CustomFields []jira.IssueTypeField
 }
 
 // IssueList is a list view for issues.
@@ -35,6 +36,9 @@ type IssueList struct {
 	Display    DisplayFormat
 	Refresh    tui.RefreshFunc
 	FooterText string
+	Offline    bool // Offline views the listed issue data instead of fetching it from the server.
+	// Client is used to apply the bulk actions. The default client is used if it is not set.
+	Client *jira.Client
 }
 
 // Render renders the view.
@@ -73,6 +77,9 @@ func (l *IssueList) Render() error {
 		tui.WithViewModeFunc(func(r, c int, _ any) (func() any, func(any) (string, error)) {
 			dataFn := func() any {
 				ci := data.GetIndex(fieldKey)
+				if l.Offline {
+					return l.issue(data.Get(r, ci))
+				}
 				iss, _ := api.ProxyGetIssue(api.DefaultClient(false), data.Get(r, ci), issue.NewNumCommentsFilter(l.Display.Comments))
 				return iss
 			}
@@ -91,31 +98,10 @@ func (l *IssueList) Render() error {
 		tui.WithMoveFunc(func(r, c int) func() (string, []string, tui.MoveHandlerFunc, string, tui.RefreshTableStateFunc) {
 			dataFn := func() (string, []string, tui.MoveHandlerFunc, string, tui.RefreshTableStateFunc) {
 				key := data[r][data.GetIndex(fieldKey)]
-				client := api.DefaultClient(false)
-				transitions, _ := api.ProxyTransitions(client, key)
-
-				var actions []string
-				for _, t := range transitions {
-					actions = append(actions, t.Name)
-				}
+				actions, transition := issueTransitions(key)
 
 				actionHandler := func(state string) error {
-					var tr *jira.Transition
-					for _, t := range transitions {
-						if strings.EqualFold(t.Name, state) {
-							tr = t
-							break
-						}
-					}
-					if tr == nil {
-						return fmt.Errorf("transition '%s' not found", state)
-					}
-					_, err := client.Transition(key, &jira.TransitionRequest{
-						Transition: &jira.TransitionRequestData{
-							ID:   tr.ID.String(),
-							Name: tr.Name,
-						},
-					})
+					_, err := transition(state)
 					return err
 				}
 
@@ -130,6 +116,7 @@ func (l *IssueList) Render() error {
 		}),
 		tui.WithRefreshFunc(l.Refresh),
 		tui.WithFixedColumns(l.Display.FixedColumns),
+		tui.WithBulkActions(issueBulkActions(l.client(), l.Project, data)...),
 	)
 
 	return view.Paint(data)
@@ -145,6 +132,15 @@ func (l *IssueList) renderCSV(w io.Writer) error {
 	return renderCSV(w, l.data())
 }
 
+func (l *IssueList) issue(key string) *jira.Issue {
+	for _, iss := range l.Data {
+		if iss.Key == key {
+			return iss
+		}
+	}
+	return nil
+}
+
 func (*IssueList) validColumnsMap() map[string]struct{} {
 	columns := ValidIssueColumns()
 	out := make(map[string]struct{}, len(columns))
@@ -175,6 +171,8 @@ func (l *IssueList) header() []string {
 		c = strings.ToUpper(c)
 		if _, ok := columnsMap[c]; ok {
 			headers = append(headers, strings.ToUpper(c))
+		} else if cf := l.customField(c); cf != nil {
+			headers = append(headers, strings.ToUpper(cf.Name))
 		}
 		if c == fieldKey {
 			hasKeyCol = true
@@ -231,8 +229,34 @@ func (l *IssueList) assignColumns(columns []string, issue *jira.Issue) []string
 			bucket = append(bucket, formatDateTime(issue.Fields.Updated, jira.RFC3339, l.Display.Timezone))
 		case fieldLabels:
 			bucket = append(bucket, strings.Join(issue.Fields.Labels, ","))
+		default:
+			if cf := l.customField(column); cf != nil {
+				bucket = append(bucket, prepareTitle(formatCustomField(cf, issue.Fields.CustomFields[cf.Key], l.Display.Timezone)))
+			}
 		}
 	}
 
 	return bucket
 }
+
+// customField returns the configured custom field for the column. The column
+// can either be the name of the field, eg: "Story Points" or "story-points",
+// or the field id, eg: "customfield_10016".
+func (l *IssueList) customField(column string) *jira.IssueTypeField {
+	for i, cf := range l.Display.CustomFields {
+		name := strings.TrimSpace(cf.Name)
+		if strings.EqualFold(name, column) ||
+			strings.EqualFold(strings.ReplaceAll(name, " ", "-"), column) ||
+			strings.EqualFold(cf.Key, column) {
+			return &l.Display.CustomFields[i]
+		}
+	}
+	return nil
+}
+
+func (l *IssueList) client() *jira.Client {
+	if l.Client != nil {
+		return l.Client
+	}
+	return api.DefaultClient(false)
+}
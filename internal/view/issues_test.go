@@ -2,6 +2,7 @@ package view
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -148,6 +149,47 @@ TEST-2	Story	Open	2020-12-13 14:05:20
 	assert.Equal(t, expected, b.String())
 }
 
+func TestIssueRenderInPlainViewWithCustomFields(t *testing.T) {
+	var b bytes.Buffer
+
+	data := getIssues()
+	data[0].Fields.CustomFields = map[string]json.RawMessage{
+		"customfield_10016": json.RawMessage(`5`),
+		"customfield_10020": json.RawMessage(`[{"id": 1, "name": "Sprint 1"}, {"id": 2, "name": "Sprint 2"}]`),
+		"customfield_10030": json.RawMessage(`{"id": "10001", "value": "Mobile"}`),
+	}
+	data[1].Fields.CustomFields = map[string]json.RawMessage{
+		"customfield_10016": json.RawMessage(`13`),
+	}
+
+	customFields := []jira.IssueTypeField{
+		{Name: "Story Points", Key: "customfield_10016"},
+		{Name: "Sprint", Key: "customfield_10020"},
+		{Name: "Platform", Key: "customfield_10030"},
+	}
+	customFields[0].Schema.DataType = "number"
+	customFields[1].Schema.DataType = "array"
+	customFields[2].Schema.DataType = "option"
+
+	issue := IssueList{
+		Project: "TEST",
+		Server:  "https://test.local",
+		Data:    data,
+		Display: DisplayFormat{
+			Plain:        true,
+			NoHeaders:    false,
+			Columns:      []string{"key", "story points", "Sprint", "customfield_10030", "unknown"},
+			CustomFields: customFields,
+		},
+	}
+	assert.NoError(t, issue.renderPlain(&b, "\t"))
+
+	expected := "KEY\tSTORY POINTS\tSPRINT\tPLATFORM\n" +
+		"TEST-1\t5\tSprint 1,Sprint 2\tMobile\n" +
+		"TEST-2\t13\t\t\n"
+	assert.Equal(t, expected, b.String())
+}
+
 func TestIssueRenderInCSVFormat(t *testing.T) {
 	var b bytes.Buffer
 
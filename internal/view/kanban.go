@@ -0,0 +1,165 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const kanbanHelpText = `[default]ACTIONS AVAILABLE IN THE TUI
+----------------------------
+
+* [yellow]← → / h, l[default] to navigate through the columns
+* [yellow]↑ ↓ / j, k[default] to navigate through the cards in a column
+* [yellow]g[default] to quickly navigate to the top of the column
+* [yellow]G[default] to quickly navigate to the bottom of the column
+* [yellow]v[default] to view selected issue details
+* [yellow]m[default] to move/transition selected issue to another column
+* [yellow]CTRL + r / F5[default] to refresh the board
+* [yellow]ENTER[default] to open the selected issue in the browser
+* [yellow]c[default] to copy issue URL to the system clipboard
+* [yellow]CTRL + k[default] to copy issue key to the system clipboard
+* [yellow]q / ESC / CTRL + c[default] to quit the app
+* [yellow]?[default] to view this help page`
+
+// KanbanBoard is a kanban view for the issues in a board.
+type KanbanBoard struct {
+	Server   string
+	Board    *jira.BoardConfiguration
+	Statuses []*jira.Status
+	Data     []*jira.Issue
+	Display  DisplayFormat
+	Refresh  tui.RefreshFunc
+}
+
+// Render renders the view.
+func (kb *KanbanBoard) Render() error {
+	if kb.Display.Plain || tui.IsDumbTerminal() || tui.IsNotTTY() {
+		w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
+		return kb.renderPlain(w)
+	}
+
+	renderer, err := MDRenderer()
+	if err != nil {
+		return err
+	}
+
+	data, columns := kb.data()
+
+	view := tui.NewKanban(
+		tui.WithKanbanStyle(kb.Display.TableStyle),
+		tui.WithKanbanFooterText(fmt.Sprintf("Showing %d issues in board %q", len(kb.Data), kb.Board.Name)),
+		tui.WithKanbanHelpText(kanbanHelpText),
+		tui.WithKanbanSelectedFunc(navigate(kb.Server)),
+		tui.WithKanbanViewModeFunc(func(r, c int, d any) (func() any, func(any) (string, error)) {
+			dataFn := func() any {
+				iss, _ := api.ProxyGetIssue(api.DefaultClient(false), issueKeyFromTuiData(r, d), issue.NewNumCommentsFilter(kb.Display.Comments))
+				return iss
+			}
+			renderFn := func(i any) (string, error) {
+				iss := Issue{
+					Server:  kb.Server,
+					Data:    i.(*jira.Issue),
+					Options: IssueOption{NumComments: kb.Display.Comments},
+				}
+				return iss.RenderedOut(renderer)
+			}
+			return dataFn, renderFn
+		}),
+		tui.WithKanbanCopyFunc(copyURL(kb.Server)),
+		tui.WithKanbanCopyKeyFunc(copyKey()),
+		tui.WithKanbanMoveFunc(func(card tui.KanbanCard) func() ([]string, tui.KanbanMoveHandlerFunc) {
+			return func() ([]string, tui.KanbanMoveHandlerFunc) {
+				actions, transition := issueTransitions(card.Key)
+
+				return actions, func(state string) (string, error) {
+					tr, err := transition(state)
+					if err != nil {
+						return "", err
+					}
+					if col, ok := columns[tr.To.ID]; ok {
+						return col, nil
+					}
+					return columns[tr.To.Name], nil
+				}
+			}
+		}),
+		tui.WithKanbanRefreshFunc(kb.Refresh),
+	)
+
+	return view.Paint(data)
+}
+
+// renderPlain renders the board as a list of issues along with the column they belong to.
+func (kb *KanbanBoard) renderPlain(w io.Writer) error {
+	data, _ := kb.data()
+
+	table := tui.TableData{{"COLUMN", fieldKey, fieldType, fieldSummary, fieldAssignee}}
+	for _, col := range data {
+		for _, card := range col.Cards {
+			iss := kb.issue(card.Key)
+			table = append(table, []string{
+				col.Name, iss.Key, iss.Fields.IssueType.Name, prepareTitle(iss.Fields.Summary), iss.Fields.Assignee.Name,
+			})
+		}
+	}
+	if kb.Display.NoHeaders {
+		table = table[1:]
+	}
+
+	return renderPlain(w, table, "\t")
+}
+
+func (kb *KanbanBoard) issue(key string) *jira.Issue {
+	for _, iss := range kb.Data {
+		if iss.Key == key {
+			return iss
+		}
+	}
+	return nil
+}
+
+// data arranges the issues in the board columns based on their status. It also returns
+// a map of status IDs and names to the name of the column the status is mapped to.
+// Issues with a status that is not mapped to any column are not shown in the board.
+func (kb *KanbanBoard) data() (tui.KanbanData, map[string]string) {
+	names := make(map[string]string, len(kb.Statuses))
+	for _, s := range kb.Statuses {
+		names[s.ID] = s.Name
+	}
+
+	var (
+		data    = make(tui.KanbanData, 0, len(kb.Board.ColumnConfig.Columns))
+		columns = make(map[string]string)
+	)
+	for _, c := range kb.Board.ColumnConfig.Columns {
+		for _, s := range c.Statuses {
+			columns[s.ID] = c.Name
+			if name, ok := names[s.ID]; ok {
+				columns[name] = c.Name
+			}
+		}
+		data = append(data, &tui.KanbanColumn{Name: c.Name, Max: c.Max})
+	}
+
+	for _, iss := range kb.Data {
+		col, ok := columns[iss.Fields.Status.Name]
+		if !ok {
+			continue
+		}
+		for _, c := range data {
+			if c.Name == col {
+				c.Cards = append(c.Cards, tui.KanbanCard{Key: iss.Key, Summary: prepareTitle(iss.Fields.Summary)})
+				break
+			}
+		}
+	}
+
+	return data, columns
+}
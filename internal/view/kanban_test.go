@@ -0,0 +1,103 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+func kanbanBoardFixture() *KanbanBoard {
+	column := func(name string, max int, statuses ...string) *jira.BoardColumn {
+		c := jira.BoardColumn{Name: name, Max: max}
+		for _, s := range statuses {
+			c.Statuses = append(c.Statuses, struct {
+				ID string `json:"id"`
+			}{ID: s})
+		}
+		return &c
+	}
+	issue := func(key, typ, status, summary string) *jira.Issue {
+		iss := jira.Issue{Key: key}
+		iss.Fields.Summary = summary
+		iss.Fields.IssueType = jira.IssueType{Name: typ}
+		iss.Fields.Status.Name = status
+		return &iss
+	}
+
+	board := jira.BoardConfiguration{ID: 1, Name: "Board 1", Type: "kanban"}
+	board.ColumnConfig.Columns = []*jira.BoardColumn{
+		column("Backlog", 0, "1"),
+		column("In Progress", 2, "3", "4"),
+		column("Done", 0, "5"),
+	}
+
+	return &KanbanBoard{
+		Server: "https://test.local",
+		Board:  &board,
+		Statuses: []*jira.Status{
+			{ID: "1", Name: "To Do"},
+			{ID: "3", Name: "In Progress"},
+			{ID: "4", Name: "In Review"},
+			{ID: "5", Name: "Done"},
+			{ID: "6", Name: "Archived"},
+		},
+		Data: []*jira.Issue{
+			issue("TEST-1", "Bug", "In Review", "Fix the login"),
+			issue("TEST-2", "Story", "To Do", "Add a dashboard"),
+			issue("TEST-3", "Task", "Archived", "Old task"),
+			issue("TEST-4", "Story", "In Progress", "Expose the metrics"),
+			issue("TEST-5", "Task", "Done", "Update the docs"),
+		},
+	}
+}
+
+func TestKanbanBoardData(t *testing.T) {
+	data, columns := kanbanBoardFixture().data()
+
+	expected := tui.KanbanData{
+		{Name: "Backlog", Cards: []tui.KanbanCard{{Key: "TEST-2", Summary: "Add a dashboard"}}},
+		{Name: "In Progress", Max: 2, Cards: []tui.KanbanCard{
+			{Key: "TEST-1", Summary: "Fix the login"},
+			{Key: "TEST-4", Summary: "Expose the metrics"},
+		}},
+		{Name: "Done", Cards: []tui.KanbanCard{{Key: "TEST-5", Summary: "Update the docs"}}},
+	}
+	assert.Equal(t, expected, data)
+
+	assert.Equal(t, map[string]string{
+		"1": "Backlog", "To Do": "Backlog",
+		"3": "In Progress", "In Progress": "In Progress",
+		"4": "In Progress", "In Review": "In Progress",
+		"5": "Done", "Done": "Done",
+	}, columns)
+}
+
+func TestKanbanBoardRenderPlain(t *testing.T) {
+	var b bytes.Buffer
+
+	kb := kanbanBoardFixture()
+	assert.NoError(t, kb.renderPlain(&b))
+
+	expected := `COLUMN	KEY	TYPE	SUMMARY	ASSIGNEE
+Backlog	TEST-2	Story	Add a dashboard	
+In Progress	TEST-1	Bug	Fix the login	
+In Progress	TEST-4	Story	Expose the metrics	
+Done	TEST-5	Task	Update the docs	
+`
+	assert.Equal(t, expected, b.String())
+
+	b.Reset()
+	kb.Display.NoHeaders = true
+	assert.NoError(t, kb.renderPlain(&b))
+
+	expected = `Backlog	TEST-2	Story	Add a dashboard	
+In Progress	TEST-1	Bug	Fix the login	
+In Progress	TEST-4	Story	Expose the metrics	
+Done	TEST-5	Task	Update the docs	
+`
+	assert.Equal(t, expected, b.String())
+}
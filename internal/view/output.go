@@ -0,0 +1,236 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Output is an output format to print the list items using a Go template
+// or as JSON with only the selected fields.
+type Output struct {
+	// Template is executed once for each item in the list.
+	Template string
+	// JSONFields are the fields to select, eg: key, summary, customfield_10016.
+	// The template is executed with the selected fields if both are set.
+	JSONFields []string
+	// CustomFields are the configured custom fields used to resolve
+	// custom fields by their name.
+	CustomFields []jira.IssueTypeField
+	// Timezone is the timezone to format the dates in.
+	Timezone string
+}
+
+// Enabled tells if the list should be printed using the output format.
+func (o Output) Enabled() bool {
+	return o.Template != "" || len(o.JSONFields) > 0
+}
+
+// RenderOutput prints the items using the template or as JSON with the selected fields.
+func RenderOutput[T any](w io.Writer, o Output, items []T) error {
+	data := make([]any, 0, len(items))
+	for _, item := range items {
+		if len(o.JSONFields) == 0 {
+			data = append(data, item)
+			continue
+		}
+		selected, err := o.selectFields(item)
+		if err != nil {
+			return err
+		}
+		data = append(data, selected)
+	}
+
+	if o.Template == "" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(data)
+	}
+
+	tmpl, err := template.New("output").Funcs(o.funcs()).Parse(o.Template)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	for _, item := range data {
+		buf.Reset()
+		if err := tmpl.Execute(&buf, item); err != nil {
+			return err
+		}
+		if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// selectFields returns the selected fields of the item. Fields are looked up in the top level
+// JSON object of the item first and then in the issue fields. Nested fields can be selected
+// using a dot, eg: status.name.
+func (o Output) selectFields(item any) (map[string]any, error) {
+	b, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+
+	fields, _ := obj["fields"].(map[string]any)
+	if iss, ok := item.(*jira.Issue); ok && fields != nil {
+		for id, raw := range iss.Fields.CustomFields {
+			var v any
+			if err := json.Unmarshal(raw, &v); err == nil {
+				fields[id] = v
+			}
+		}
+	}
+
+	out := make(map[string]any, len(o.JSONFields))
+	for _, f := range o.JSONFields {
+		path := strings.Split(f, ".")
+		path[0] = o.customFieldID(path[0])
+
+		v, ok := lookup(obj, path)
+		if !ok && fields != nil {
+			v, _ = lookup(fields, path)
+		}
+		out[f] = v
+	}
+
+	return out, nil
+}
+
+// customFieldID returns the ID of the custom field if the name matches a configured custom field.
+func (o Output) customFieldID(name string) string {
+	for _, cf := range o.CustomFields {
+		identifier := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(cf.Name)), " ", "-")
+		if strings.EqualFold(cf.Name, name) || identifier == strings.ToLower(name) {
+			return cf.Key
+		}
+	}
+	return name
+}
+
+func (o Output) funcs() template.FuncMap {
+	return template.FuncMap{
+		"date": func(layout, value string) string {
+			t, ok := parseDate(value, o.Timezone)
+			if !ok {
+				return value
+			}
+			return t.Format(layout)
+		},
+		"humanDate": func(value string) string {
+			t, ok := parseDate(value, o.Timezone)
+			if !ok {
+				return value
+			}
+			return cmdutil.FormatDateTimeHuman(t.Format(time.RFC3339), time.RFC3339)
+		},
+		"text": adfToText,
+		"customField": func(name string, iss *jira.Issue) string {
+			if iss == nil {
+				return ""
+			}
+			raw, ok := iss.Fields.CustomFields[o.customFieldID(name)]
+			if !ok {
+				return ""
+			}
+			return jira.CustomFieldValue(raw)
+		},
+		"join": func(sep string, items []string) string {
+			return strings.Join(items, sep)
+		},
+		"truncate": func(limit int, s string) string {
+			if limit <= 0 || len([]rune(s)) <= limit {
+				return s
+			}
+			return string([]rune(s)[:limit])
+		},
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}
+}
+
+// lookup finds the value in the nested JSON object. Keys are matched case-insensitively.
+func lookup(obj map[string]any, path []string) (any, bool) {
+	var cur any = obj
+	for _, key := range path {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		v, ok := m[key]
+		if !ok {
+			for k, val := range m {
+				if strings.EqualFold(k, key) {
+					v, ok = val, true
+					break
+				}
+			}
+		}
+		if !ok {
+			return nil, false
+		}
+		cur = v
+	}
+	return cur, true
+}
+
+// parseDate parses the date in one of the formats used by Jira.
+func parseDate(value, tz string) (time.Time, bool) {
+	for _, layout := range []string{jira.RFC3339MilliLayout, jira.RFC3339, time.RFC3339, cmdutil.DateLayout} {
+		t, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		if loc, err := time.LoadLocation(tz); tz != "" && err == nil {
+			t = t.In(loc)
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// adfToText converts the description or the comment body to a plain markdown text.
+func adfToText(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case *adf.ADF:
+		if val == nil {
+			return ""
+		}
+		return strings.TrimSpace(adf.NewTranslator(val, adf.NewMarkdownTranslator()).Translate())
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	var doc adf.ADF
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(adf.NewTranslator(&doc, adf.NewMarkdownTranslator()).Translate())
+}
@@ -0,0 +1,121 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestOutputEnabled(t *testing.T) {
+	assert.False(t, Output{}.Enabled())
+	assert.True(t, Output{Template: "{{.Key}}"}.Enabled())
+	assert.True(t, Output{JSONFields: []string{"key"}}.Enabled())
+}
+
+func TestRenderOutputTemplate(t *testing.T) {
+	var b bytes.Buffer
+
+	issues := getIssues()
+	issues[0].Fields.CustomFields = map[string]json.RawMessage{
+		"customfield_10016": json.RawMessage(`5`),
+	}
+
+	out := Output{
+		Template: `{{.Key}} {{.Fields.Summary | truncate 9}} [{{join "," .Fields.Labels}}] ` +
+			`{{date "2006-01-02" .Fields.Created}} {{customField "story-points" .}}`,
+		CustomFields: []jira.IssueTypeField{{Name: "Story Points", Key: "customfield_10016"}},
+		Timezone:     "UTC",
+	}
+	assert.NoError(t, RenderOutput(&b, out, issues))
+
+	expected := "TEST-1 This is a [krakatit] 2020-12-13 5\n" +
+		"TEST-2 This is a [pat,mat] 2020-12-13 \n"
+	assert.Equal(t, expected, b.String())
+}
+
+func TestRenderOutputTemplateWithText(t *testing.T) {
+	var b bytes.Buffer
+
+	issues := getIssues()
+	issues[0].Fields.Description = map[string]any{
+		"version": 1,
+		"type":    "doc",
+		"content": []any{
+			map[string]any{
+				"type": "paragraph",
+				"content": []any{
+					map[string]any{"type": "text", "text": "Description in ADF"},
+				},
+			},
+		},
+	}
+	issues[1].Fields.Description = "Description in plain text"
+
+	assert.NoError(t, RenderOutput(&b, Output{Template: "{{.Key}}: {{text .Fields.Description}}\n"}, issues))
+
+	expected := `TEST-1: Description in ADF
+TEST-2: Description in plain text
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestRenderOutputInvalidTemplate(t *testing.T) {
+	var b bytes.Buffer
+
+	err := RenderOutput(&b, Output{Template: "{{.Key"}, getIssues())
+	assert.ErrorContains(t, err, "invalid template")
+}
+
+func TestRenderOutputJSON(t *testing.T) {
+	var b bytes.Buffer
+
+	issues := getIssues()
+	issues[0].Fields.CustomFields = map[string]json.RawMessage{
+		"customfield_10016": json.RawMessage(`5`),
+	}
+
+	out := Output{
+		JSONFields:   []string{"key", "summary", "status.name", "Story Points", "customfield_10020"},
+		CustomFields: []jira.IssueTypeField{{Name: "Story Points", Key: "customfield_10016"}},
+	}
+	assert.NoError(t, RenderOutput(&b, out, issues))
+
+	expected := `[
+  {
+    "Story Points": 5,
+    "customfield_10020": null,
+    "key": "TEST-1",
+    "status.name": "Done",
+    "summary": "This is a test"
+  },
+  {
+    "Story Points": null,
+    "customfield_10020": null,
+    "key": "TEST-2",
+    "status.name": "Open",
+    "summary": "This is another test"
+  }
+]
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestRenderOutputJSONWithTemplate(t *testing.T) {
+	var b bytes.Buffer
+
+	sprints := []*jira.Sprint{
+		{ID: 1, Name: "Sprint 1", Status: "closed"},
+		{ID: 2, Name: "Sprint 2", Status: "active"},
+	}
+
+	out := Output{
+		Template:   "{{.id}}\t{{.name}}",
+		JSONFields: []string{"id", "name"},
+	}
+	assert.NoError(t, RenderOutput(&b, out, sprints))
+	assert.Equal(t, "1\tSprint 1\n2\tSprint 2\n", b.String())
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -82,3 +84,98 @@ func (r Release) printHeader() {
 	}
 	_, _ = fmt.Fprintln(r.writer)
 }
+
+// ReleaseDetail is a view for a release along with the issues in it.
+type ReleaseDetail struct {
+	Release *jira.ProjectVersion
+	Issues  []*jira.Issue
+}
+
+// Render renders the release details, status breakdown and the issues in the release.
+func (rd ReleaseDetail) Render() error {
+	var b bytes.Buffer
+
+	if err := rd.render(&b); err != nil {
+		return err
+	}
+	return tui.PagerOut(b.String())
+}
+
+func (rd ReleaseDetail) render(out io.Writer) error {
+	r := rd.Release
+
+	state := "Unreleased"
+	switch {
+	case r.Archived:
+		state = "Archived"
+	case r.Released:
+		state = "Released"
+	case r.Overdue:
+		state = "Overdue"
+	}
+	_, _ = fmt.Fprintf(out, "%s (%s)\n", r.Name, state)
+
+	if r.StartDate != "" || r.ReleaseDate != "" {
+		_, _ = fmt.Fprintf(out, "Start date: %s\tRelease date: %s\n", orDash(r.StartDate), orDash(r.ReleaseDate))
+	}
+	if r.Description != nil && fmt.Sprint(r.Description) != "" {
+		_, _ = fmt.Fprintln(out, fmt.Sprint(r.Description))
+	}
+
+	if len(rd.Issues) == 0 {
+		_, _ = fmt.Fprintln(out, "\nNo issues in the release")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, tabWidth, 1, '\t', 0)
+
+	_, _ = fmt.Fprintln(w, "\nSTATUS\tISSUES\tPERCENT")
+	for _, s := range rd.statusBreakdown() {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d%%\n", s.name, s.count, s.count*100/len(rd.Issues))
+	}
+	_, _ = fmt.Fprintf(w, "Total\t%d\t\n", len(rd.Issues))
+
+	_, _ = fmt.Fprintln(w, "\nKEY\tTYPE\tSTATUS\tASSIGNEE\tSUMMARY")
+	for _, iss := range rd.Issues {
+		_, _ = fmt.Fprintf(
+			w, "%s\t%s\t%s\t%s\t%s\n",
+			iss.Key, iss.Fields.IssueType.Name, iss.Fields.Status.Name,
+			orDash(iss.Fields.Assignee.Name), strings.TrimSpace(iss.Fields.Summary),
+		)
+	}
+
+	return w.Flush()
+}
+
+type statusCount struct {
+	name  string
+	count int
+}
+
+// statusBreakdown counts the issues per status, the statuses with most issues come first.
+func (rd ReleaseDetail) statusBreakdown() []statusCount {
+	counts := make(map[string]int)
+	for _, iss := range rd.Issues {
+		counts[iss.Fields.Status.Name]++
+	}
+
+	out := make([]statusCount, 0, len(counts))
+	for name, count := range counts {
+		out = append(out, statusCount{name: name, count: count})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].count != out[j].count {
+			return out[i].count > out[j].count
+		}
+		return out[i].name < out[j].name
+	})
+
+	return out
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,220 @@
+package view
+
+import (
+	"fmt"
+	htmlTemplate "html/template"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Release notes formats.
+const (
+	ReleaseNotesMarkdown = "markdown"
+	ReleaseNotesHTML     = "html"
+)
+
+// Release notes groupings.
+const (
+	GroupByType      = "type"
+	GroupByComponent = "component"
+	GroupByLabel     = "label"
+)
+
+// releaseNotesOtherGroup holds issues without a component or a label.
+const releaseNotesOtherGroup = "Other"
+
+const markdownReleaseNotes = `# {{ .Name }}
+{{- if .ReleaseDate }}
+
+Release date: {{ .ReleaseDate }}
+{{- end }}
+{{- if .Description }}
+
+{{ .Description }}
+{{- end }}
+{{- range .Groups }}
+
+## {{ .Name }}
+{{ range .Issues }}
+- [{{ .Key }}]({{ .URL }}) {{ .Summary }}
+{{- end }}
+{{- end }}
+`
+
+const htmlReleaseNotes = `<h1>{{ .Name }}</h1>
+{{- if .ReleaseDate }}
+<p>Release date: {{ .ReleaseDate }}</p>
+{{- end }}
+{{- if .Description }}
+<p>{{ .Description }}</p>
+{{- end }}
+{{- range .Groups }}
+<h2>{{ .Name }}</h2>
+<ul>
+{{- range .Issues }}
+  <li><a href="{{ .URL }}">{{ .Key }}</a> {{ .Summary }}</li>
+{{- end }}
+</ul>
+{{- end }}
+`
+
+// ReleaseNoteIssue is an issue as exposed to the release notes templates.
+type ReleaseNoteIssue struct {
+	Key        string
+	Summary    string
+	Type       string
+	Status     string
+	Assignee   string
+	URL        string
+	Components []string
+	Labels     []string
+}
+
+// ReleaseNoteGroup is a group of issues in the release notes, eg: all bugs.
+type ReleaseNoteGroup struct {
+	Name   string
+	Issues []ReleaseNoteIssue
+}
+
+// ReleaseNotesData is the data passed to the release notes templates.
+type ReleaseNotesData struct {
+	Name        string
+	Description string
+	StartDate   string
+	ReleaseDate string
+	Released    bool
+	Groups      []ReleaseNoteGroup
+	Issues      []ReleaseNoteIssue
+}
+
+// ReleaseNotes is a view that generates release notes from the issues in a release.
+type ReleaseNotes struct {
+	Server  string
+	Release *jira.ProjectVersion
+	Issues  []*jira.Issue
+	GroupBy string
+	Format  string
+	// Template is a user provided Go template, it takes precedence over the format.
+	Template string
+}
+
+// Render renders the release notes to the writer.
+func (rn ReleaseNotes) Render(w io.Writer) error {
+	data, err := rn.data()
+	if err != nil {
+		return err
+	}
+
+	if rn.Template != "" {
+		tmpl, err := template.New("notes").Funcs(template.FuncMap{"join": strings.Join}).Parse(rn.Template)
+		if err != nil {
+			return fmt.Errorf("invalid template: %w", err)
+		}
+		return tmpl.Execute(w, data)
+	}
+
+	switch rn.Format {
+	case "", ReleaseNotesMarkdown:
+		return template.Must(template.New("notes").Parse(markdownReleaseNotes)).Execute(w, data)
+	case ReleaseNotesHTML:
+		return htmlTemplate.Must(htmlTemplate.New("notes").Parse(htmlReleaseNotes)).Execute(w, data)
+	default:
+		return fmt.Errorf("unknown format %q, valid formats are: %s, %s", rn.Format, ReleaseNotesMarkdown, ReleaseNotesHTML)
+	}
+}
+
+func (rn ReleaseNotes) data() (*ReleaseNotesData, error) {
+	issues := make([]ReleaseNoteIssue, 0, len(rn.Issues))
+	for _, iss := range rn.Issues {
+		components := make([]string, 0, len(iss.Fields.Components))
+		for _, c := range iss.Fields.Components {
+			components = append(components, c.Name)
+		}
+
+		issues = append(issues, ReleaseNoteIssue{
+			Key:        iss.Key,
+			Summary:    strings.TrimSpace(iss.Fields.Summary),
+			Type:       iss.Fields.IssueType.Name,
+			Status:     iss.Fields.Status.Name,
+			Assignee:   iss.Fields.Assignee.Name,
+			URL:        cmdutil.GenerateServerBrowseURL(rn.Server, iss.Key),
+			Components: components,
+			Labels:     iss.Fields.Labels,
+		})
+	}
+
+	groups, err := groupReleaseNotes(issues, rn.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var desc string
+	if rn.Release.Description != nil {
+		desc = fmt.Sprint(rn.Release.Description)
+	}
+
+	return &ReleaseNotesData{
+		Name:        rn.Release.Name,
+		Description: desc,
+		StartDate:   rn.Release.StartDate,
+		ReleaseDate: rn.Release.ReleaseDate,
+		Released:    rn.Release.Released,
+		Groups:      groups,
+		Issues:      issues,
+	}, nil
+}
+
+// groupReleaseNotes groups the issues by type, component or label. An issue appears
+// in every component or label group it belongs to, the groups are sorted by name.
+func groupReleaseNotes(issues []ReleaseNoteIssue, groupBy string) ([]ReleaseNoteGroup, error) {
+	var keys func(ReleaseNoteIssue) []string
+
+	switch groupBy {
+	case "", GroupByType:
+		keys = func(iss ReleaseNoteIssue) []string { return []string{iss.Type} }
+	case GroupByComponent:
+		keys = func(iss ReleaseNoteIssue) []string { return iss.Components }
+	case GroupByLabel:
+		keys = func(iss ReleaseNoteIssue) []string { return iss.Labels }
+	default:
+		return nil, fmt.Errorf(
+			"unknown group %q, valid groups are: %s, %s, %s",
+			groupBy, GroupByType, GroupByComponent, GroupByLabel,
+		)
+	}
+
+	var (
+		names  []string
+		groups = make(map[string][]ReleaseNoteIssue)
+		others []ReleaseNoteIssue
+	)
+	for _, iss := range issues {
+		ks := keys(iss)
+		if len(ks) == 0 {
+			others = append(others, iss)
+			continue
+		}
+		for _, k := range ks {
+			if _, ok := groups[k]; !ok {
+				names = append(names, k)
+			}
+			groups[k] = append(groups[k], iss)
+		}
+	}
+	sort.Strings(names)
+
+	out := make([]ReleaseNoteGroup, 0, len(names)+1)
+	for _, n := range names {
+		out = append(out, ReleaseNoteGroup{Name: n, Issues: groups[n]})
+	}
+	if len(others) > 0 {
+		out = append(out, ReleaseNoteGroup{Name: releaseNotesOtherGroup, Issues: others})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,133 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func releaseNotesFixture() ReleaseNotes {
+	issue := func(key, typ, summary string, components []string, labels ...string) *jira.Issue {
+		iss := jira.Issue{Key: key}
+		iss.Fields.Summary = summary
+		iss.Fields.IssueType = jira.IssueType{Name: typ}
+		iss.Fields.Labels = labels
+		for _, c := range components {
+			iss.Fields.Components = append(iss.Fields.Components, struct {
+				Name string `json:"name"`
+			}{Name: c})
+		}
+		return &iss
+	}
+
+	return ReleaseNotes{
+		Server: "https://test.local",
+		Release: &jira.ProjectVersion{
+			ID:          "1000",
+			Name:        "v1.0.0",
+			Description: "First stable release",
+			ReleaseDate: "2022-01-31",
+		},
+		Issues: []*jira.Issue{
+			issue("TEST-1", "Story", "Add a dashboard", []string{"Web"}, "ui"),
+			issue("TEST-2", "Bug", "Fix the <login> page", []string{"Web", "API"}),
+			issue("TEST-3", "Story", "Expose the metrics", []string{"API"}, "api", "metrics"),
+			issue("TEST-4", "Task", "Update the docs", nil),
+		},
+	}
+}
+
+func TestReleaseNotesMarkdown(t *testing.T) {
+	var b bytes.Buffer
+
+	rn := releaseNotesFixture()
+	assert.NoError(t, rn.Render(&b))
+
+	expected := `# v1.0.0
+
+Release date: 2022-01-31
+
+First stable release
+
+## Bug
+
+- [TEST-2](https://test.local/browse/TEST-2) Fix the <login> page
+
+## Story
+
+- [TEST-1](https://test.local/browse/TEST-1) Add a dashboard
+- [TEST-3](https://test.local/browse/TEST-3) Expose the metrics
+
+## Task
+
+- [TEST-4](https://test.local/browse/TEST-4) Update the docs
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestReleaseNotesHTMLGroupedByComponent(t *testing.T) {
+	var b bytes.Buffer
+
+	rn := releaseNotesFixture()
+	rn.Format = ReleaseNotesHTML
+	rn.GroupBy = GroupByComponent
+	assert.NoError(t, rn.Render(&b))
+
+	expected := `<h1>v1.0.0</h1>
+<p>Release date: 2022-01-31</p>
+<p>First stable release</p>
+<h2>API</h2>
+<ul>
+  <li><a href="https://test.local/browse/TEST-2">TEST-2</a> Fix the &lt;login&gt; page</li>
+  <li><a href="https://test.local/browse/TEST-3">TEST-3</a> Expose the metrics</li>
+</ul>
+<h2>Web</h2>
+<ul>
+  <li><a href="https://test.local/browse/TEST-1">TEST-1</a> Add a dashboard</li>
+  <li><a href="https://test.local/browse/TEST-2">TEST-2</a> Fix the &lt;login&gt; page</li>
+</ul>
+<h2>Other</h2>
+<ul>
+  <li><a href="https://test.local/browse/TEST-4">TEST-4</a> Update the docs</li>
+</ul>
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestReleaseNotesTemplate(t *testing.T) {
+	var b bytes.Buffer
+
+	rn := releaseNotesFixture()
+	rn.GroupBy = GroupByLabel
+	rn.Template = `{{ .Name }}: {{ len .Issues }} issues
+{{ range .Groups }}{{ .Name }}:{{ range .Issues }} {{ .Key }}({{ join .Components "," }}){{ end }}
+{{ end }}`
+	assert.NoError(t, rn.Render(&b))
+
+	expected := `v1.0.0: 4 issues
+api: TEST-3(API)
+metrics: TEST-3(API)
+ui: TEST-1(Web)
+Other: TEST-2(Web,API) TEST-4()
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestReleaseNotesErrors(t *testing.T) {
+	var b bytes.Buffer
+
+	rn := releaseNotesFixture()
+	rn.GroupBy = "assignee"
+	assert.EqualError(t, rn.Render(&b), `unknown group "assignee", valid groups are: type, component, label`)
+
+	rn = releaseNotesFixture()
+	rn.Format = "pdf"
+	assert.EqualError(t, rn.Render(&b), `unknown format "pdf", valid formats are: markdown, html`)
+
+	rn = releaseNotesFixture()
+	rn.Template = "{{ .Name "
+	assert.ErrorContains(t, rn.Render(&b), "invalid template")
+}
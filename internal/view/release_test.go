@@ -32,3 +32,66 @@ func TestReleaseRender(t *testing.T) {
 `
 	assert.Equal(t, expected, b.String())
 }
+
+func TestReleaseDetailRender(t *testing.T) {
+	issue := func(key, typ, status, assignee, summary string) *jira.Issue {
+		iss := jira.Issue{Key: key}
+		iss.Fields.Summary = summary
+		iss.Fields.IssueType = jira.IssueType{Name: typ}
+		iss.Fields.Status.Name = status
+		iss.Fields.Assignee.Name = assignee
+		return &iss
+	}
+
+	rd := ReleaseDetail{
+		Release: &jira.ProjectVersion{
+			ID:          "1000",
+			Name:        "v1.0.0",
+			Description: "First stable release",
+			StartDate:   "2022-01-03",
+			ReleaseDate: "2022-01-31",
+		},
+		Issues: []*jira.Issue{
+			issue("TEST-1", "Bug", "Done", "Person A", "Fix the login "),
+			issue("TEST-2", "Story", "In Progress", "", "Add a dashboard"),
+			issue("TEST-3", "Task", "Done", "Person B", "Update the docs"),
+			issue("TEST-4", "Bug", "To Do", "Person A", "Fix the logout"),
+		},
+	}
+
+	var b bytes.Buffer
+	assert.NoError(t, rd.render(&b))
+
+	expected := `v1.0.0 (Unreleased)
+Start date: 2022-01-03	Release date: 2022-01-31
+First stable release
+
+STATUS		ISSUES	PERCENT
+Done		2	50%
+In Progress	1	25%
+To Do		1	25%
+Total		4	
+
+KEY	TYPE	STATUS		ASSIGNEE	SUMMARY
+TEST-1	Bug	Done		Person A	Fix the login
+TEST-2	Story	In Progress	-		Add a dashboard
+TEST-3	Task	Done		Person B	Update the docs
+TEST-4	Bug	To Do		Person A	Fix the logout
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestReleaseDetailRenderWithoutIssues(t *testing.T) {
+	rd := ReleaseDetail{
+		Release: &jira.ProjectVersion{ID: "1001", Name: "v1.1.0", Released: true, Archived: true},
+	}
+
+	var b bytes.Buffer
+	assert.NoError(t, rd.render(&b))
+
+	expected := `v1.1.0 (Archived)
+
+No issues in the release
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -0,0 +1,134 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/internal/template"
+)
+
+// TemplateOption is a functional option to wrap template list properties.
+type TemplateOption func(*TemplateList)
+
+// TemplateList is a list view for issue templates.
+type TemplateList struct {
+	data   []*template.Template
+	writer io.Writer
+}
+
+// NewTemplateList constructs a template list view.
+func NewTemplateList(data []*template.Template, opts ...TemplateOption) *TemplateList {
+	t := TemplateList{
+		data:   data,
+		writer: os.Stdout,
+	}
+
+	for _, opt := range opts {
+		opt(&t)
+	}
+	return &t
+}
+
+// WithTemplateWriter sets a writer for the template list.
+func WithTemplateWriter(w io.Writer) TemplateOption {
+	return func(t *TemplateList) {
+		t.writer = w
+	}
+}
+
+// Render renders the template list view.
+func (t TemplateList) Render() error {
+	w := tabwriter.NewWriter(t.writer, 0, tabWidth, 1, '\t', 0)
+
+	_, _ = fmt.Fprintln(w, "NAME\tTYPE\tVARIABLES\tDESCRIPTION\tPATH")
+
+	for _, d := range t.data {
+		_, _ = fmt.Fprintf(
+			w, "%s\t%s\t%s\t%s\t%s\n",
+			d.Name, d.Type, strings.Join(d.Variables(), ", "), d.Description, d.Path,
+		)
+	}
+
+	return w.Flush()
+}
+
+// TemplateDetail is a view for an issue template.
+type TemplateDetail struct {
+	Template *template.Template
+}
+
+// Render renders the template fields followed by the description.
+func (td TemplateDetail) Render() error {
+	return td.render(os.Stdout)
+}
+
+func (td TemplateDetail) render(out io.Writer) error {
+	t := td.Template
+	w := tabwriter.NewWriter(out, 0, tabWidth, 1, '\t', 0)
+
+	row := func(label, value string) {
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", label, value)
+	}
+	field := func(label, value string) {
+		if value != "" {
+			row(label+":", value)
+		}
+	}
+
+	field("Name", t.Name)
+	field("Path", t.Path)
+	field("Description", t.Description)
+	field("Type", t.Type)
+	field("Summary", t.Summary)
+	field("Priority", t.Priority)
+	field("Labels", strings.Join(t.Labels, ", "))
+	field("Components", strings.Join(t.Components, ", "))
+
+	if len(t.CustomFields) > 0 {
+		keys := make([]string, 0, len(t.CustomFields))
+		for k := range t.CustomFields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		pairs := make([]string, 0, len(keys))
+		for _, k := range keys {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", k, t.CustomFields[k]))
+		}
+		field("Custom fields", strings.Join(pairs, ", "))
+	}
+
+	label := "Variables:"
+	for _, name := range t.Variables() {
+		desc := name
+		if v := t.Vars[name]; v != nil {
+			if v.Description != "" {
+				desc += " - " + v.Description
+			}
+			if v.Default != "" {
+				desc += fmt.Sprintf(" (default: %s)", v.Default)
+			}
+		}
+		row(label, desc)
+		label = ""
+	}
+
+	label = "Sub-tasks:"
+	for _, st := range t.Subtasks {
+		row(label, st.Summary)
+		label = ""
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if body := strings.TrimSpace(t.Body); body != "" {
+		_, _ = fmt.Fprintf(out, "\n%s\n", body)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/template"
+)
+
+const testTemplate = `---
+description: Report a bug
+type: Bug
+summary: "[{{component}}] {{title}}"
+labels: [bug]
+custom:
+  story-points: 3
+subtasks:
+  - summary: Reproduce the bug
+  - summary: Write a regression test
+vars:
+  component:
+    description: Affected component
+    default: API
+---
+Steps to reproduce
+`
+
+func TestTemplateListRender(t *testing.T) {
+	var b bytes.Buffer
+
+	bug, err := template.Parse([]byte(testTemplate), false)
+	assert.NoError(t, err)
+	bug.Name, bug.Path = "bug", "/templates/bug.md"
+
+	story, err := template.Parse([]byte("As a user"), false)
+	assert.NoError(t, err)
+	story.Name, story.Path = "story", "/templates/story.md"
+
+	assert.NoError(t, NewTemplateList([]*template.Template{bug, story}, WithTemplateWriter(&b)).Render())
+
+	expected := "NAME\tTYPE\tVARIABLES\t\tDESCRIPTION\tPATH\n" +
+		"bug\tBug\tcomponent, title\tReport a bug\t/templates/bug.md\n" +
+		"story\t\t\t\t\t\t\t/templates/story.md\n"
+	assert.Equal(t, expected, b.String())
+}
+
+func TestTemplateDetailRender(t *testing.T) {
+	var b bytes.Buffer
+
+	bug, err := template.Parse([]byte(testTemplate), false)
+	assert.NoError(t, err)
+	bug.Name, bug.Path = "bug", "/templates/bug.md"
+
+	assert.NoError(t, TemplateDetail{Template: bug}.render(&b))
+
+	expected := "Name:\t\tbug\n" +
+		"Path:\t\t/templates/bug.md\n" +
+		"Description:\tReport a bug\n" +
+		"Type:\t\tBug\n" +
+		"Summary:\t[{{component}}] {{title}}\n" +
+		"Labels:\t\tbug\n" +
+		"Custom fields:\tstory-points=3\n" +
+		"Variables:\tcomponent - Affected component (default: API)\n" +
+		"\t\ttitle\n" +
+		"Sub-tasks:\tReproduce the bug\n" +
+		"\t\tWrite a regression test\n" +
+		"\nSteps to reproduce\n"
+	assert.Equal(t, expected, b.String())
+}
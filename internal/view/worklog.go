@@ -0,0 +1,282 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const (
+	// WorklogGroupByIssue groups worklog report by issue.
+	WorklogGroupByIssue = "issue"
+	// WorklogGroupByDay groups worklog report by day.
+	WorklogGroupByDay = "day"
+	// WorklogGroupByUser groups worklog report by user.
+	WorklogGroupByUser = "user"
+
+	maxWorklogCommentLength = 60
+)
+
+// WorklogList is a list view for issue worklogs.
+type WorklogList struct {
+	Issue   string
+	Total   int
+	Data    []*jira.Worklog
+	Display DisplayFormat
+}
+
+// Render renders the worklog list view.
+func (wl WorklogList) Render() error {
+	if wl.Display.CSV {
+		return wl.renderCSV(os.Stdout)
+	}
+
+	// custom delimiter is used only in plain mode, otherwise \t is used
+	delimiter := "\t"
+	if wl.Display.Plain {
+		delimiter = wl.Display.Delimiter
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
+
+	return wl.renderPlain(w, delimiter)
+}
+
+// renderPlain renders worklogs in plain view.
+func (wl WorklogList) renderPlain(w io.Writer, delimiter string) error {
+	return renderPlain(w, wl.data(), delimiter)
+}
+
+// renderCSV renders worklogs in csv format.
+func (wl WorklogList) renderCSV(w io.Writer) error {
+	return renderCSV(w, wl.data())
+}
+
+func (wl WorklogList) header() []string {
+	return []string{
+		"ID",
+		"AUTHOR",
+		"STARTED",
+		"TIME SPENT",
+		"COMMENT",
+	}
+}
+
+func (wl WorklogList) data() tui.TableData {
+	var data tui.TableData
+
+	if !wl.Display.NoHeaders {
+		data = append(data, wl.header())
+	}
+	for _, w := range wl.Data {
+		data = append(data, []string{
+			w.ID,
+			authorName(w.Author),
+			formatDateTime(w.Started, jira.RFC3339, wl.Display.Timezone),
+			w.TimeSpent,
+			wl.comment(w.Comment),
+		})
+	}
+
+	return data
+}
+
+func (wl WorklogList) comment(c string) string {
+	// CSV can hold multi-line values, so we will keep the comment as is.
+	if wl.Display.CSV {
+		return c
+	}
+
+	c = strings.Join(strings.Fields(c), " ")
+	if wl.Display.NoTruncate {
+		return c
+	}
+	return strings.TrimSpace(shortenAndPad(c, maxWorklogCommentLength))
+}
+
+// WorklogReportEntry is a worklog along with the issue it belongs to.
+type WorklogReportEntry struct {
+	Key     string
+	Summary string
+	Worklog *jira.Worklog
+}
+
+// WorklogReport is a view to display time spent aggregated by issue, day and/or user.
+type WorklogReport struct {
+	Data    []WorklogReportEntry
+	GroupBy []string
+	Display DisplayFormat
+}
+
+type worklogReportRow struct {
+	key, summary, day, user string
+	seconds                 int
+}
+
+// Render renders the worklog report.
+func (wr WorklogReport) Render() error {
+	if wr.Display.CSV {
+		return wr.renderCSV(os.Stdout)
+	}
+
+	// custom delimiter is used only in plain mode, otherwise \t is used
+	delimiter := "\t"
+	if wr.Display.Plain {
+		delimiter = wr.Display.Delimiter
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
+
+	return wr.renderPlain(w, delimiter)
+}
+
+// renderPlain renders the report in plain view along with the grand total.
+func (wr WorklogReport) renderPlain(w io.Writer, delimiter string) error {
+	data := wr.data()
+
+	var total int
+	for _, r := range wr.rows() {
+		total += r.seconds
+	}
+	footer := make([]string, len(wr.header()))
+	footer[0] = "TOTAL"
+	footer[len(footer)-2] = formatDuration(total)
+	footer[len(footer)-1] = formatHours(total)
+
+	return renderPlain(w, append(data, footer), delimiter)
+}
+
+// renderCSV renders the report in csv format.
+func (wr WorklogReport) renderCSV(w io.Writer) error {
+	return renderCSV(w, wr.data())
+}
+
+func (wr WorklogReport) groupBy(dim string) bool {
+	if len(wr.GroupBy) == 0 {
+		return true
+	}
+	for _, g := range wr.GroupBy {
+		if strings.EqualFold(strings.TrimSpace(g), dim) {
+			return true
+		}
+	}
+	return false
+}
+
+func (wr WorklogReport) header() []string {
+	var headers []string
+
+	if wr.groupBy(WorklogGroupByDay) {
+		headers = append(headers, "DATE")
+	}
+	if wr.groupBy(WorklogGroupByIssue) {
+		headers = append(headers, "KEY", "SUMMARY")
+	}
+	if wr.groupBy(WorklogGroupByUser) {
+		headers = append(headers, "USER")
+	}
+
+	return append(headers, "TIME SPENT", "HOURS")
+}
+
+func (wr WorklogReport) rows() []*worklogReportRow {
+	var (
+		rows    []*worklogReportRow
+		grouped = make(map[string]*worklogReportRow)
+	)
+
+	for _, e := range wr.Data {
+		var r worklogReportRow
+
+		if wr.groupBy(WorklogGroupByDay) {
+			r.day = worklogDay(e.Worklog.Started, wr.Display.Timezone)
+		}
+		if wr.groupBy(WorklogGroupByIssue) {
+			r.key, r.summary = e.Key, e.Summary
+		}
+		if wr.groupBy(WorklogGroupByUser) {
+			r.user = authorName(e.Worklog.Author)
+		}
+
+		id := strings.Join([]string{r.day, r.key, r.user}, "\x00")
+		if g, ok := grouped[id]; ok {
+			g.seconds += e.Worklog.TimeSpentSeconds
+			continue
+		}
+		r.seconds = e.Worklog.TimeSpentSeconds
+		grouped[id] = &r
+		rows = append(rows, &r)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].day != rows[j].day {
+			return rows[i].day < rows[j].day
+		}
+		if rows[i].key != rows[j].key {
+			return rows[i].key < rows[j].key
+		}
+		return rows[i].user < rows[j].user
+	})
+
+	return rows
+}
+
+func (wr WorklogReport) data() tui.TableData {
+	var data tui.TableData
+
+	if !wr.Display.NoHeaders {
+		data = append(data, wr.header())
+	}
+	for _, r := range wr.rows() {
+		var row []string
+
+		if wr.groupBy(WorklogGroupByDay) {
+			row = append(row, r.day)
+		}
+		if wr.groupBy(WorklogGroupByIssue) {
+			row = append(row, r.key, prepareTitle(r.summary))
+		}
+		if wr.groupBy(WorklogGroupByUser) {
+			row = append(row, r.user)
+		}
+		data = append(data, append(row, formatDuration(r.seconds), formatHours(r.seconds)))
+	}
+
+	return data
+}
+
+func worklogDay(started, tz string) string {
+	t, err := time.Parse(jira.RFC3339, started)
+	if err != nil {
+		return started
+	}
+	if tz != "" {
+		if loc, err := time.LoadLocation(tz); err == nil {
+			t = t.In(loc)
+		}
+	}
+	return t.Format("2006-01-02")
+}
+
+// formatDuration formats seconds in Jira duration format, eg: 2h 30m.
+func formatDuration(seconds int) string {
+	h, m := seconds/3600, (seconds%3600)/60
+
+	switch {
+	case h > 0 && m > 0:
+		return fmt.Sprintf("%dh %dm", h, m)
+	case h > 0:
+		return fmt.Sprintf("%dh", h)
+	default:
+		return fmt.Sprintf("%dm", m)
+	}
+}
+
+func formatHours(seconds int) string {
+	return fmt.Sprintf("%.2f", float64(seconds)/3600)
+}
@@ -0,0 +1,147 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func getTestWorklogs() []*jira.Worklog {
+	return []*jira.Worklog{
+		{
+			ID:               "20000",
+			Author:           jira.User{DisplayName: "Person A"},
+			Comment:          "Investigation\nand fix",
+			Started:          "2022-01-03T09:30:00.000+0100",
+			TimeSpent:        "2h 30m",
+			TimeSpentSeconds: 9000,
+		},
+		{
+			ID:               "20001",
+			Author:           jira.User{Name: "person-b"},
+			Started:          "2022-01-03T23:30:00.000+0100",
+			TimeSpent:        "1h",
+			TimeSpentSeconds: 3600,
+		},
+		{
+			ID:               "20002",
+			Author:           jira.User{DisplayName: "Person A"},
+			Started:          "2022-01-04T10:00:00.000+0100",
+			TimeSpent:        "30m",
+			TimeSpentSeconds: 1800,
+		},
+	}
+}
+
+func TestWorklogListRenderInPlainView(t *testing.T) {
+	var b bytes.Buffer
+
+	worklogs := WorklogList{
+		Issue:   "TEST-1",
+		Data:    getTestWorklogs(),
+		Display: DisplayFormat{Plain: true, Timezone: "UTC"},
+	}
+	assert.NoError(t, worklogs.renderPlain(&b, "|"))
+
+	expected := `ID|AUTHOR|STARTED|TIME SPENT|COMMENT
+20000|Person A|2022-01-03 08:30:00|2h 30m|Investigation and fix
+20001|person-b|2022-01-03 22:30:00|1h|
+20002|Person A|2022-01-04 09:00:00|30m|
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestWorklogListRenderInCSV(t *testing.T) {
+	var b bytes.Buffer
+
+	worklogs := WorklogList{
+		Issue:   "TEST-1",
+		Data:    getTestWorklogs()[:1],
+		Display: DisplayFormat{CSV: true, NoHeaders: true, Timezone: "UTC"},
+	}
+	assert.NoError(t, worklogs.renderCSV(&b))
+
+	expected := `20000,Person A,2022-01-03 08:30:00,2h 30m,"Investigation
+and fix"
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestWorklogReport(t *testing.T) {
+	t.Parallel()
+
+	wl := getTestWorklogs()
+	entries := []WorklogReportEntry{
+		{Key: "TEST-2", Summary: "Second issue", Worklog: wl[0]},
+		{Key: "TEST-1", Summary: "First issue", Worklog: wl[1]},
+		{Key: "TEST-1", Summary: "First issue", Worklog: wl[2]},
+		{Key: "TEST-2", Summary: "Second issue", Worklog: wl[2]},
+	}
+
+	cases := []struct {
+		name     string
+		groupBy  []string
+		expected string
+	}{
+		{
+			name:    "it groups by issue, day and user by default",
+			groupBy: nil,
+			expected: `DATE|KEY|SUMMARY|USER|TIME SPENT|HOURS
+2022-01-03|TEST-1|First issue|person-b|1h|1.00
+2022-01-03|TEST-2|Second issue|Person A|2h 30m|2.50
+2022-01-04|TEST-1|First issue|Person A|30m|0.50
+2022-01-04|TEST-2|Second issue|Person A|30m|0.50
+TOTAL||||4h 30m|4.50
+`,
+		},
+		{
+			name:    "it groups by issue",
+			groupBy: []string{"issue"},
+			expected: `KEY|SUMMARY|TIME SPENT|HOURS
+TEST-1|First issue|1h 30m|1.50
+TEST-2|Second issue|3h|3.00
+TOTAL||4h 30m|4.50
+`,
+		},
+		{
+			name:    "it groups by day and user",
+			groupBy: []string{"day", "user"},
+			expected: `DATE|USER|TIME SPENT|HOURS
+2022-01-03|Person A|2h 30m|2.50
+2022-01-03|person-b|1h|1.00
+2022-01-04|Person A|1h|1.00
+TOTAL||4h 30m|4.50
+`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b bytes.Buffer
+
+			report := WorklogReport{
+				Data:    entries,
+				GroupBy: tc.groupBy,
+				Display: DisplayFormat{Plain: true, Timezone: "UTC"},
+			}
+			assert.NoError(t, report.renderPlain(&b, "|"))
+			assert.Equal(t, tc.expected, b.String())
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0m", formatDuration(0))
+	assert.Equal(t, "45m", formatDuration(2700))
+	assert.Equal(t, "3h", formatDuration(10800))
+	assert.Equal(t, "26h 15m", formatDuration(94500))
+}
@@ -23,12 +23,15 @@ const (
 	NodeParagraph   = NodeType("paragraph")
 	NodeTable       = NodeType("table")
 	NodeMedia       = NodeType("media")
+	NodeRule        = NodeType("rule")
+	NodeTaskList    = NodeType("taskList")
 
 	ChildNodeText        = NodeType("text")
 	ChildNodeListItem    = NodeType("listItem")
 	ChildNodeTableRow    = NodeType("tableRow")
 	ChildNodeTableHeader = NodeType("tableHeader")
 	ChildNodeTableCell   = NodeType("tableCell")
+	ChildNodeTaskItem    = NodeType("taskItem")
 
 	InlineNodeCard      = NodeType("inlineCard")
 	InlineNodeEmoji     = NodeType("emoji")
@@ -135,6 +138,8 @@ func ParentNodes() []NodeType {
 		NodeParagraph,
 		NodeTable,
 		NodeMedia,
+		NodeRule,
+		NodeTaskList,
 	}
 }
 
@@ -146,6 +151,7 @@ func ChildNodes() []NodeType {
 		ChildNodeTableRow,
 		ChildNodeTableHeader,
 		ChildNodeTableCell,
+		ChildNodeTaskItem,
 	}
 }
 
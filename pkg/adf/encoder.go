@@ -0,0 +1,504 @@
+package adf
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+const (
+	taskStateTodo = "TODO"
+	taskStateDone = "DONE"
+)
+
+var (
+	mentionRegex = regexp.MustCompile(`\[~accountid:([^\]\s]+)\]`)
+	alertRegex   = regexp.MustCompile(`(?i)^\[!(note|tip|important|warning|caution|info|success|error)\]\s*`)
+
+	// alertPanelTypes maps GitHub flavored alerts to the ADF panel types.
+	alertPanelTypes = map[string]string{
+		"note":      panelTypeInfo,
+		"info":      panelTypeInfo,
+		"tip":       panelTypeSuccess,
+		"success":   panelTypeSuccess,
+		"important": panelTypeNote,
+		"warning":   panelTypeWarning,
+		"caution":   panelTypeError,
+		"error":     panelTypeError,
+	}
+
+	// Nodes allowed inside list items and blockquotes, other block
+	// nodes are converted to one of these or unwrapped.
+	nestedBlockNodes = []NodeType{
+		NodeParagraph,
+		NodeBulletList,
+		NodeOrderedList,
+		NodeTaskList,
+		NodeCodeBlock,
+	}
+)
+
+// FromMarkdown encodes CommonMark, along with GFM tables, task lists and strikethrough, to
+// an ADF document. In addition to the CommonMark syntax, it supports:
+//   - Jira style mentions, eg: [~accountid:5b10ac8d82e05b22cc7d4ef5].
+//   - GitHub style alerts, eg: > [!NOTE], which are converted to panels.
+func FromMarkdown(md string) *ADF {
+	src := []byte(md)
+
+	parser := goldmark.New(goldmark.WithExtensions(extension.GFM)).Parser()
+	doc := parser.Parse(text.NewReader(src))
+
+	enc := encoder{src: src}
+
+	return &ADF{
+		Version: 1,
+		DocType: "doc",
+		Content: enc.blocks(doc),
+	}
+}
+
+type encoder struct {
+	src    []byte
+	nextID int
+}
+
+func (e *encoder) localID() string {
+	e.nextID++
+	return strconv.Itoa(e.nextID)
+}
+
+func (e *encoder) blocks(parent gast.Node) []*Node {
+	nodes := make([]*Node, 0, parent.ChildCount())
+	for c := parent.FirstChild(); c != nil; c = c.NextSibling() {
+		nodes = append(nodes, e.block(c)...)
+	}
+	return nodes
+}
+
+//nolint:gocyclo
+func (e *encoder) block(n gast.Node) []*Node {
+	switch v := n.(type) {
+	case *gast.Paragraph, *gast.TextBlock:
+		return []*Node{{NodeType: NodeParagraph, Content: e.inlines(v)}}
+	case *gast.Heading:
+		return []*Node{{
+			NodeType:   NodeHeading,
+			Attributes: map[string]any{"level": v.Level},
+			Content:    e.inlines(v),
+		}}
+	case *gast.ThematicBreak:
+		return []*Node{{NodeType: NodeRule}}
+	case *gast.FencedCodeBlock:
+		node := e.codeBlock(v)
+		if lang := v.Language(e.src); len(lang) > 0 {
+			node.Attributes = map[string]any{"language": string(lang)}
+		}
+		return []*Node{node}
+	case *gast.CodeBlock:
+		return []*Node{e.codeBlock(v)}
+	case *gast.HTMLBlock:
+		return []*Node{e.codeBlock(v)}
+	case *gast.Blockquote:
+		return []*Node{e.blockquote(v)}
+	case *gast.List:
+		return []*Node{e.list(v)}
+	case *extast.Table:
+		return []*Node{e.table(v)}
+	}
+
+	// Unknown block, eg: from an extension, we will try to keep the content.
+	return e.blocks(n)
+}
+
+func (e *encoder) codeBlock(n gast.Node) *Node {
+	var code strings.Builder
+
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		code.Write(line.Value(e.src))
+	}
+
+	node := Node{NodeType: NodeCodeBlock}
+	if s := strings.TrimRight(code.String(), "\n"); s != "" {
+		node.Content = []*Node{{NodeType: ChildNodeText, NodeValue: NodeValue{Text: s}}}
+	}
+	return &node
+}
+
+func (e *encoder) blockquote(n *gast.Blockquote) *Node {
+	content := e.blocks(n)
+
+	// GitHub style alerts are converted to panels.
+	if len(content) > 0 && content[0].NodeType == NodeParagraph && len(content[0].Content) > 0 {
+		first := content[0].Content[0]
+		if m := alertRegex.FindStringSubmatch(first.Text); first.NodeType == ChildNodeText && m != nil {
+			first.Text = strings.TrimPrefix(first.Text, m[0])
+			if first.Text == "" {
+				content[0].Content = content[0].Content[1:]
+			}
+			if len(content[0].Content) == 0 {
+				content = content[1:]
+			}
+			return &Node{
+				NodeType:   NodePanel,
+				Attributes: map[string]any{"panelType": alertPanelTypes[strings.ToLower(m[1])]},
+				Content:    restrict(content, append(slices.Clone(nestedBlockNodes), NodeHeading)),
+			}
+		}
+	}
+
+	return &Node{NodeType: NodeBlockquote, Content: restrict(content, nestedBlockNodes)}
+}
+
+func (e *encoder) list(n *gast.List) *Node {
+	if e.isTaskList(n) {
+		return e.taskList(n)
+	}
+
+	node := Node{NodeType: NodeBulletList}
+	if n.IsOrdered() {
+		node.NodeType = NodeOrderedList
+		if n.Start != 1 {
+			node.Attributes = map[string]any{"order": n.Start}
+		}
+	}
+
+	for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+		content := restrict(e.blocks(item), nestedBlockNodes)
+		if len(content) == 0 || content[0].NodeType != NodeParagraph {
+			// List item must start with a paragraph.
+			content = append([]*Node{{NodeType: NodeParagraph}}, content...)
+		}
+		node.Content = append(node.Content, &Node{NodeType: ChildNodeListItem, Content: content})
+	}
+
+	return &node
+}
+
+// isTaskList checks if all items of the list start with a checkbox, and only
+// contains other task lists, so that the list can be converted to a task list.
+func (e *encoder) isTaskList(n *gast.List) bool {
+	if n.IsOrdered() {
+		return false
+	}
+	for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+		first := item.FirstChild()
+		if first == nil || first.FirstChild() == nil || first.FirstChild().Kind() != extast.KindTaskCheckBox {
+			return false
+		}
+		for c := first.NextSibling(); c != nil; c = c.NextSibling() {
+			l, ok := c.(*gast.List)
+			if !ok || !e.isTaskList(l) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (e *encoder) taskList(n *gast.List) *Node {
+	node := Node{
+		NodeType:   NodeTaskList,
+		Attributes: map[string]any{"localId": e.localID()},
+	}
+
+	for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+		first := item.FirstChild()
+
+		state := taskStateTodo
+		if cb, ok := first.FirstChild().(*extast.TaskCheckBox); ok && cb.IsChecked {
+			state = taskStateDone
+		}
+
+		// Skip the checkbox itself.
+		content := e.inlinesFrom(first.FirstChild().NextSibling())
+		if len(content) > 0 && content[0].NodeType == ChildNodeText {
+			content[0].Text = strings.TrimLeft(content[0].Text, " ")
+			if content[0].Text == "" {
+				content = content[1:]
+			}
+		}
+
+		node.Content = append(node.Content, &Node{
+			NodeType:   ChildNodeTaskItem,
+			Attributes: map[string]any{"localId": e.localID(), "state": state},
+			Content:    content,
+		})
+
+		// Nested task lists are siblings of the task item in ADF.
+		for c := first.NextSibling(); c != nil; c = c.NextSibling() {
+			node.Content = append(node.Content, e.taskList(c.(*gast.List)))
+		}
+	}
+
+	return &node
+}
+
+func (e *encoder) table(n *extast.Table) *Node {
+	node := Node{NodeType: NodeTable}
+
+	for row := n.FirstChild(); row != nil; row = row.NextSibling() {
+		cellType := ChildNodeTableCell
+		if row.Kind() == extast.KindTableHeader {
+			cellType = ChildNodeTableHeader
+		}
+
+		r := Node{NodeType: ChildNodeTableRow}
+		for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+			r.Content = append(r.Content, &Node{
+				NodeType: cellType,
+				Content:  []*Node{{NodeType: NodeParagraph, Content: e.inlines(cell)}},
+			})
+		}
+		node.Content = append(node.Content, &r)
+	}
+
+	return &node
+}
+
+// inlines encodes the inline children of a node. The adjacent text nodes
+// with same marks are merged, and the mentions are extracted afterward.
+func (e *encoder) inlines(parent gast.Node) []*Node {
+	return e.inlinesFrom(parent.FirstChild())
+}
+
+// inlinesFrom is same as inlines but starts from the given child.
+func (e *encoder) inlinesFrom(first gast.Node) []*Node {
+	var nodes []*Node
+	for c := first; c != nil; c = c.NextSibling() {
+		nodes = append(nodes, e.inline(c, nil)...)
+	}
+	return mentions(merge(nodes))
+}
+
+//nolint:gocyclo
+func (e *encoder) inline(n gast.Node, marks []MarkNode) []*Node {
+	switch v := n.(type) {
+	case *gast.Text:
+		value := v.Segment.Value(e.src)
+		if !v.IsRaw() {
+			value = unescape(value)
+		}
+		nodes := []*Node{textNode(string(value), marks)}
+		switch {
+		case v.HardLineBreak():
+			nodes = append(nodes, &Node{NodeType: InlineNodeHardBreak})
+		case v.SoftLineBreak():
+			nodes = append(nodes, textNode(" ", marks))
+		}
+		return nodes
+	case *gast.String:
+		value := v.Value
+		if !v.IsCode() && !v.IsRaw() {
+			value = unescape(value)
+		}
+		return []*Node{textNode(string(value), marks)}
+	case *gast.CodeSpan:
+		var code strings.Builder
+		for c := v.FirstChild(); c != nil; c = c.NextSibling() {
+			if t, ok := c.(*gast.Text); ok {
+				code.Write(t.Segment.Value(e.src))
+			}
+		}
+		return []*Node{textNode(code.String(), withMark(marks, MarkNode{MarkType: MarkCode}))}
+	case *gast.Emphasis:
+		mark := MarkNode{MarkType: MarkEm}
+		if v.Level == 2 {
+			mark.MarkType = MarkStrong
+		}
+		return e.inlineChildren(v, withMark(marks, mark))
+	case *extast.Strikethrough:
+		return e.inlineChildren(v, withMark(marks, MarkNode{MarkType: MarkStrike}))
+	case *gast.Link:
+		return e.inlineChildren(v, withMark(marks, linkMark(string(v.Destination), string(v.Title))))
+	case *gast.AutoLink:
+		url := string(v.URL(e.src))
+		href := url
+		if v.AutoLinkType == gast.AutoLinkEmail && !strings.HasPrefix(strings.ToLower(url), "mailto:") {
+			href = "mailto:" + url
+		}
+		return []*Node{textNode(url, withMark(marks, linkMark(href, "")))}
+	case *gast.Image:
+		// Images need to be uploaded as attachments to be embedded, so we will link to them instead.
+		nodes := e.inlineChildren(v, withMark(marks, linkMark(string(v.Destination), string(v.Title))))
+		if len(nodes) == 0 {
+			nodes = []*Node{textNode(string(v.Destination), withMark(marks, linkMark(string(v.Destination), "")))}
+		}
+		return nodes
+	case *gast.RawHTML:
+		var raw strings.Builder
+		for i := 0; i < v.Segments.Len(); i++ {
+			seg := v.Segments.At(i)
+			raw.Write(seg.Value(e.src))
+		}
+		return []*Node{textNode(raw.String(), marks)}
+	case *extast.TaskCheckBox:
+		// Checkboxes outside the task lists are kept as is.
+		if v.IsChecked {
+			return []*Node{textNode("[x]", marks)}
+		}
+		return []*Node{textNode("[ ]", marks)}
+	}
+
+	return e.inlineChildren(n, marks)
+}
+
+func (e *encoder) inlineChildren(n gast.Node, marks []MarkNode) []*Node {
+	var nodes []*Node
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		nodes = append(nodes, e.inline(c, marks)...)
+	}
+	return nodes
+}
+
+func textNode(s string, marks []MarkNode) *Node {
+	return &Node{
+		NodeType:  ChildNodeText,
+		NodeValue: NodeValue{Text: s, Marks: marks},
+	}
+}
+
+func linkMark(href, title string) MarkNode {
+	attrs := map[string]any{"href": href}
+	if title != "" {
+		attrs["title"] = title
+	}
+	return MarkNode{MarkType: MarkLink, Attributes: attrs}
+}
+
+// withMark returns a copy of marks with the new mark added. Code mark
+// can only be combined with a link, so other marks are dropped for it.
+func withMark(marks []MarkNode, mark MarkNode) []MarkNode {
+	out := make([]MarkNode, 0, len(marks)+1)
+	for _, m := range marks {
+		if mark.MarkType == MarkCode && m.MarkType != MarkLink {
+			continue
+		}
+		out = append(out, m)
+	}
+	return append(out, mark)
+}
+
+func sameMarks(a, b []MarkNode) bool {
+	return slices.EqualFunc(a, b, func(x, y MarkNode) bool {
+		if x.MarkType != y.MarkType {
+			return false
+		}
+		if x.MarkType != MarkLink {
+			return true
+		}
+		xa, _ := x.Attributes.(map[string]any)
+		ya, _ := y.Attributes.(map[string]any)
+		return xa["href"] == ya["href"] && xa["title"] == ya["title"]
+	})
+}
+
+// merge merges adjacent text nodes with same marks and removes empty text nodes.
+// Trailing whitespace left by the soft line breaks is removed as well.
+func merge(nodes []*Node) []*Node {
+	out := make([]*Node, 0, len(nodes))
+	for _, n := range nodes {
+		if n.NodeType == ChildNodeText {
+			if n.Text == "" {
+				continue
+			}
+			if len(out) > 0 {
+				prev := out[len(out)-1]
+				if prev.NodeType == ChildNodeText && sameMarks(prev.Marks, n.Marks) {
+					prev.Text += n.Text
+					continue
+				}
+			}
+		}
+		out = append(out, n)
+	}
+
+	if len(out) > 0 {
+		last := out[len(out)-1]
+		if last.NodeType == ChildNodeText && len(last.Marks) == 0 {
+			last.Text = strings.TrimRight(last.Text, " ")
+			if last.Text == "" {
+				out = out[:len(out)-1]
+			}
+		}
+	}
+
+	return out
+}
+
+// mentions extracts Jira style mentions, eg: [~accountid:123], from the text nodes.
+func mentions(nodes []*Node) []*Node {
+	out := make([]*Node, 0, len(nodes))
+	for _, n := range nodes {
+		if n.NodeType != ChildNodeText || slices.ContainsFunc(n.Marks, isCodeMark) {
+			out = append(out, n)
+			continue
+		}
+
+		matches := mentionRegex.FindAllStringSubmatchIndex(n.Text, -1)
+		if len(matches) == 0 {
+			out = append(out, n)
+			continue
+		}
+
+		pos := 0
+		for _, m := range matches {
+			if m[0] > pos {
+				out = append(out, textNode(n.Text[pos:m[0]], n.Marks))
+			}
+			out = append(out, &Node{
+				NodeType:   InlineNodeMention,
+				Attributes: map[string]any{"id": n.Text[m[2]:m[3]]},
+			})
+			pos = m[1]
+		}
+		if pos < len(n.Text) {
+			out = append(out, textNode(n.Text[pos:], n.Marks))
+		}
+	}
+	return out
+}
+
+func isCodeMark(m MarkNode) bool {
+	return m.MarkType == MarkCode
+}
+
+// restrict makes sure that the nodes only contain the allowed block nodes. Headings
+// are converted to paragraphs, rules are dropped and other blocks are unwrapped.
+func restrict(nodes []*Node, allowed []NodeType) []*Node {
+	out := make([]*Node, 0, len(nodes))
+	for _, n := range nodes {
+		switch {
+		case slices.Contains(allowed, n.NodeType):
+			out = append(out, n)
+		case n.NodeType == NodeHeading:
+			out = append(out, &Node{NodeType: NodeParagraph, Content: n.Content})
+		case n.NodeType == NodeRule:
+			continue
+		case n.NodeType == NodeTable:
+			for _, row := range n.Content {
+				for _, cell := range row.Content {
+					out = append(out, restrict(cell.Content, allowed)...)
+				}
+			}
+		default:
+			out = append(out, restrict(n.Content, allowed)...)
+		}
+	}
+	return out
+}
+
+func unescape(b []byte) []byte {
+	b = util.UnescapePunctuations(b)
+	b = util.ResolveNumericReferences(b)
+	return util.ResolveEntityNames(b)
+}
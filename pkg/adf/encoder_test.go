@@ -0,0 +1,137 @@
+package adf
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromMarkdown(t *testing.T) {
+	md, err := os.ReadFile("./testdata/md.md")
+	assert.NoError(t, err)
+
+	expected, err := os.ReadFile("./testdata/md_encoded.json")
+	assert.NoError(t, err)
+
+	actual, err := json.Marshal(FromMarkdown(string(md)))
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, string(expected), string(actual))
+}
+
+func TestFromMarkdownInline(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected []*Node
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: []*Node{},
+		},
+		{
+			name:  "code drops other marks",
+			input: "**`code`**",
+			expected: []*Node{{
+				NodeType: NodeParagraph,
+				Content: []*Node{
+					textNode("code", []MarkNode{{MarkType: MarkCode}}),
+				},
+			}},
+		},
+		{
+			name:  "mention in code is kept as is",
+			input: "`[~accountid:123]` [~accountid:456]",
+			expected: []*Node{{
+				NodeType: NodeParagraph,
+				Content: []*Node{
+					textNode("[~accountid:123]", []MarkNode{{MarkType: MarkCode}}),
+					textNode(" ", nil),
+					{NodeType: InlineNodeMention, Attributes: map[string]any{"id": "456"}},
+				},
+			}},
+		},
+		{
+			name:  "email autolink",
+			input: "<jon@example.com>",
+			expected: []*Node{{
+				NodeType: NodeParagraph,
+				Content: []*Node{
+					textNode("jon@example.com", []MarkNode{linkMark("mailto:jon@example.com", "")}),
+				},
+			}},
+		},
+		{
+			name:  "hard break",
+			input: "line 1\\\nline 2",
+			expected: []*Node{{
+				NodeType: NodeParagraph,
+				Content: []*Node{
+					textNode("line 1", nil),
+					{NodeType: InlineNodeHardBreak},
+					textNode("line 2", nil),
+				},
+			}},
+		},
+		{
+			name:  "heading inside list item",
+			input: "- # Heading",
+			expected: []*Node{{
+				NodeType: NodeBulletList,
+				Content: []*Node{{
+					NodeType: ChildNodeListItem,
+					Content: []*Node{{
+						NodeType: NodeParagraph,
+						Content:  []*Node{textNode("Heading", nil)},
+					}},
+				}},
+			}},
+		},
+		{
+			name:  "ordered list with start",
+			input: "3. Three",
+			expected: []*Node{{
+				NodeType:   NodeOrderedList,
+				Attributes: map[string]any{"order": 3},
+				Content: []*Node{{
+					NodeType: ChildNodeListItem,
+					Content: []*Node{{
+						NodeType: NodeParagraph,
+						Content:  []*Node{textNode("Three", nil)},
+					}},
+				}},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, FromMarkdown(tc.input).Content)
+		})
+	}
+}
+
+func TestFromMarkdownRoundTrip(t *testing.T) {
+	data, err := os.ReadFile("./testdata/md.json")
+	assert.NoError(t, err)
+
+	var doc ADF
+	assert.NoError(t, json.Unmarshal(data, &doc))
+
+	translate := func(doc *ADF) string {
+		return NewTranslator(doc, NewMarkdownTranslator()).Translate()
+	}
+
+	// Markdown generated from the encoded document should be stable.
+	first := translate(FromMarkdown(translate(&doc)))
+	second := translate(FromMarkdown(first))
+
+	assert.Equal(t, first, second)
+}
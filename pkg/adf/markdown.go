@@ -101,6 +101,14 @@ func (tr *MarkdownTranslator) Open(n Connector, _ int) string {
 			tag.WriteString("\n")
 		case NodeMedia:
 			tag.WriteString("\n[attachment]")
+		case NodeRule:
+			tag.WriteString("---\n")
+		case ChildNodeTaskItem:
+			if a, ok := attrs.(map[string]any); ok && a["state"] == taskStateDone {
+				tag.WriteString("- [x] ")
+			} else {
+				tag.WriteString("- [ ] ")
+			}
 		case NodeBulletList:
 			tr.list.depthU++
 			tr.list.ul[tr.list.depthU] = true
@@ -180,6 +188,8 @@ func (tr *MarkdownTranslator) Close(n Connector) string {
 			tag.WriteString("---\n")
 		case NodeHeading:
 			tag.WriteString("\n")
+		case ChildNodeTaskItem:
+			tag.WriteString("\n")
 		case NodeBulletList:
 			tr.list.ul[tr.list.depthU] = false
 			tr.list.depthU--
@@ -248,7 +258,7 @@ func (tr *MarkdownTranslator) setOpenTagAttributes(a any) string {
 				tag.WriteString(fmt.Sprintf("%s", v))
 				nl = true
 			case "level":
-				for range int(v.(float64)) {
+				for range toInt(v) {
 					tag.WriteString("#")
 				}
 				tag.WriteString(" ")
@@ -286,3 +296,15 @@ func (*MarkdownTranslator) isValidAttr(attr string) bool {
 	known := []string{"language", "level", "text"}
 	return slices.Contains(known, attr)
 }
+
+// toInt converts a numeric attribute to int. Attributes decoded from
+// JSON are float64 whereas the ones from the encoder are int.
+func toInt(v any) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case float64:
+		return int(n)
+	}
+	return 0
+}
@@ -0,0 +1,99 @@
+package git
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotRepository is returned if the current directory is not inside a git repository.
+var ErrNotRepository = errors.New("not a git repository")
+
+// CurrentBranch returns the name of the branch checked out in the current directory.
+// An empty name is returned if the HEAD is detached.
+func CurrentBranch() (string, error) {
+	out, err := run("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	if out == "HEAD" {
+		return "", nil
+	}
+	return out, nil
+}
+
+// Branches returns the names of the local branches.
+func Branches() ([]string, error) {
+	out, err := run("for-each-ref", "--format=%(refname:short)", "refs/heads/")
+	if err != nil {
+		return nil, err
+	}
+	if out == "" {
+		return nil, nil
+	}
+	return strings.Split(out, "\n"), nil
+}
+
+// BranchExists checks if a local branch with the given name exists.
+func BranchExists(name string) bool {
+	_, err := run("show-ref", "--verify", "--quiet", "refs/heads/"+name)
+	return err == nil
+}
+
+// ValidateBranchName checks if the name can be used as a branch name.
+func ValidateBranchName(name string) error {
+	if _, err := run("check-ref-format", "--branch", name); err != nil {
+		return fmt.Errorf("invalid branch name %q", name)
+	}
+	return nil
+}
+
+// Checkout checks out an existing branch.
+func Checkout(name string) error {
+	_, err := run("checkout", name)
+	return err
+}
+
+// CreateBranch creates a new branch from the base, or from the HEAD
+// if the base is empty, and checks it out.
+func CreateBranch(name, base string) error {
+	args := []string{"checkout", "-b", name}
+	if base != "" {
+		args = append(args, base)
+	}
+	_, err := run(args...)
+	return err
+}
+
+// HooksDir returns the absolute path to the hooks directory of the
+// current repository. The core.hooksPath config is respected.
+func HooksDir() (string, error) {
+	dir, err := run("rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", err
+	}
+	return filepath.Abs(dir)
+}
+
+func run(args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if strings.Contains(msg, "not a git repository") {
+			return "", ErrNotRepository
+		}
+		if msg == "" {
+			return "", err
+		}
+		return "", errors.New(strings.TrimPrefix(msg, "fatal: "))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
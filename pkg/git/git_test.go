@@ -0,0 +1,93 @@
+package git
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupRepo(t *testing.T) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	// The temp dir may be a symlink, eg: on macOS, while git returns the resolved paths.
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	assert.NoError(t, err)
+
+	t.Chdir(dir)
+	t.Setenv("GIT_CONFIG_GLOBAL", filepath.Join(dir, ".gitconfig"))
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+
+	for _, args := range [][]string{
+		{"init", "--quiet", "--initial-branch", "main"},
+		{"config", "user.name", "Jira CLI"},
+		{"config", "user.email", "jira@example.com"},
+		{"commit", "--quiet", "--allow-empty", "-m", "Initial commit"},
+	} {
+		_, err := run(args...)
+		assert.NoError(t, err)
+	}
+
+	return dir
+}
+
+func TestBranches(t *testing.T) {
+	setupRepo(t)
+
+	branch, err := CurrentBranch()
+	assert.NoError(t, err)
+	assert.Equal(t, "main", branch)
+
+	assert.NoError(t, ValidateBranchName("feature/TEST-1-fix-login"))
+	assert.Error(t, ValidateBranchName("feature/TEST-1..fix"))
+
+	assert.False(t, BranchExists("feature/TEST-1-fix-login"))
+	assert.NoError(t, CreateBranch("feature/TEST-1-fix-login", ""))
+	assert.True(t, BranchExists("feature/TEST-1-fix-login"))
+
+	branch, err = CurrentBranch()
+	assert.NoError(t, err)
+	assert.Equal(t, "feature/TEST-1-fix-login", branch)
+
+	assert.NoError(t, CreateBranch("feature/TEST-2", "main"))
+	assert.NoError(t, Checkout("feature/TEST-1-fix-login"))
+
+	branches, err := Branches()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"feature/TEST-1-fix-login", "feature/TEST-2", "main"}, branches)
+
+	assert.Error(t, CreateBranch("feature/TEST-2", ""))
+}
+
+func TestHooksDir(t *testing.T) {
+	dir := setupRepo(t)
+
+	hooks, err := HooksDir()
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, ".git", "hooks"), hooks)
+
+	_, err = run("config", "core.hooksPath", ".githooks")
+	assert.NoError(t, err)
+
+	hooks, err = HooksDir()
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, ".githooks"), hooks)
+}
+
+func TestNotRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("GIT_CEILING_DIRECTORIES", filepath.Dir(dir))
+
+	_, err := CurrentBranch()
+	assert.ErrorIs(t, err, ErrNotRepository)
+}
@@ -0,0 +1,171 @@
+package jira
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIssueAttachments(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1?fields=attachment", r.URL.RequestURI())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/attachments.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueAttachments("TEST-1")
+	assert.NoError(t, err)
+
+	expected := []*Attachment{
+		{
+			ID:       "10000",
+			Filename: "app.log",
+			Author:   User{AccountID: "a-b-c", DisplayName: "Person A", Active: true},
+			Created:  "2020-12-03T14:05:20.974+0100",
+			Size:     2048,
+			MimeType: "text/plain",
+			Content:  "https://test.local/rest/api/2/attachment/content/10000",
+		},
+		{
+			ID:        "10001",
+			Filename:  "screenshot.png",
+			Author:    User{AccountID: "d-e-f", DisplayName: "Person B", Active: true},
+			Created:   "2020-12-04T10:15:00.000+0100",
+			Size:      1048576,
+			MimeType:  "image/png",
+			Content:   "https://test.local/rest/api/2/attachment/content/10001",
+			Thumbnail: "https://test.local/rest/api/2/attachment/thumbnail/10001",
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueAttachments("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestAddIssueAttachment(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/attachments", r.URL.Path)
+		assert.Equal(t, "no-check", r.Header.Get("X-Atlassian-Token"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		file, header, err := r.FormFile("file")
+		assert.NoError(t, err)
+		assert.Equal(t, "app.log", header.Filename)
+
+		content, err := io.ReadAll(file)
+		assert.NoError(t, err)
+		assert.Equal(t, "log line 1\nlog line 2\n", string(content))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{"id": "10000", "filename": "app.log", "size": 22, "mimeType": "text/plain"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.AddIssueAttachment("TEST-1", "/tmp/logs/app.log", strings.NewReader("log line 1\nlog line 2\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Attachment{{ID: "10000", Filename: "app.log", Size: 22, MimeType: "text/plain"}}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.AddIssueAttachment("TEST-1", "app.log", strings.NewReader("log"))
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	content := strings.Repeat("a", 1024)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/attachment/content/10000", r.URL.Path)
+
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(content))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	var (
+		buf             bytes.Buffer
+		lastTransferred int64
+	)
+
+	// Content URL pointing to a different host should fall back to the configured server.
+	att := &Attachment{ID: "10000", Size: 1024, Content: "https://elsewhere.local/attachment/10000"}
+
+	n, err := client.DownloadAttachment(att, &buf, func(transferred, _ int64) {
+		lastTransferred = transferred
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), n)
+	assert.Equal(t, int64(1024), lastTransferred)
+	assert.Equal(t, content, buf.String())
+
+	// Relative content URL is resolved against the configured server.
+	buf.Reset()
+	att.Content = "/rest/api/2/attachment/content/10000"
+
+	_, err = client.DownloadAttachment(att, &buf, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, content, buf.String())
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/attachment/10000", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteAttachment("10000")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteAttachment("10000")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -1,10 +1,10 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 const (
@@ -21,6 +21,26 @@ type BoardResult struct {
 	Boards     []*Board `json:"values"`
 }
 
+// BoardConfiguration holds response from /board/{boardID}/configuration endpoint.
+type BoardConfiguration struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	ColumnConfig struct {
+		Columns []*BoardColumn `json:"columns"`
+	} `json:"columnConfig"`
+}
+
+// BoardColumn is a column in a board. An issue belongs to the column its status is mapped to.
+type BoardColumn struct {
+	Name     string `json:"name"`
+	Statuses []struct {
+		ID string `json:"id"`
+	} `json:"statuses"`
+	Min int `json:"min,omitempty"`
+	Max int `json:"max,omitempty"`
+}
+
 // Boards gets all boards of a given type in a project.
 func (c *Client) Boards(project, boardType string) (*BoardResult, error) {
 	path := fmt.Sprintf("/board?projectKeyOrId=%s", project)
@@ -39,7 +59,7 @@ func (c *Client) BoardSearch(project, name string) (*BoardResult, error) {
 }
 
 func (c *Client) board(path string) (*BoardResult, error) {
-	res, err := c.GetV1(context.Background(), path, nil)
+	res, err := c.GetV1(c.Context(), path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -58,3 +78,52 @@ func (c *Client) board(path string) (*BoardResult, error) {
 
 	return &out, err
 }
+
+// GetBoardConfiguration fetches the configuration of a board, eg: the columns and their statuses.
+func (c *Client) GetBoardConfiguration(boardID int) (*BoardConfiguration, error) {
+	res, err := c.GetV1(c.Context(), fmt.Sprintf("/board/%d/configuration", boardID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out BoardConfiguration
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// BoardIssues fetches issues in the given board.
+func (c *Client) BoardIssues(boardID int, jql string, from, limit uint) (*SearchResult, error) {
+	path := fmt.Sprintf("/board/%d/issue?startAt=%d&maxResults=%d", boardID, from, limit)
+	if jql != "" {
+		path += fmt.Sprintf("&jql=%s", url.QueryEscape(jql))
+	}
+
+	res, err := c.GetV1(c.Context(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out SearchResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
@@ -81,3 +81,86 @@ func TestBoards(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+func TestGetBoardConfiguration(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/1/configuration", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/board-configuration.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetBoardConfiguration(1)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, actual.ID)
+	assert.Equal(t, "Board 1", actual.Name)
+	assert.Equal(t, "kanban", actual.Type)
+
+	columns := actual.ColumnConfig.Columns
+	assert.Len(t, columns, 3)
+	assert.Equal(t, "Backlog", columns[0].Name)
+	assert.Equal(t, "In Progress", columns[1].Name)
+	assert.Equal(t, 5, columns[1].Max)
+	assert.Len(t, columns[1].Statuses, 2)
+	assert.Equal(t, "3", columns[1].Statuses[0].ID)
+	assert.Equal(t, "10001", columns[1].Statuses[1].ID)
+	assert.Equal(t, "Done", columns[2].Name)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetBoardConfiguration(1)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestBoardIssues(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/1/issue", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, url.Values{
+				"jql":        []string{"assignee=currentUser()"},
+				"startAt":    []string{"50"},
+				"maxResults": []string{"50"},
+			}, r.URL.Query())
+
+			resp, err := os.ReadFile("./testdata/search.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.BoardIssues(1, "assignee=currentUser()", 50, 50)
+	assert.NoError(t, err)
+	assert.Len(t, actual.Issues, 3)
+	assert.Equal(t, "TEST-1", actual.Issues[0].Key)
+	assert.Equal(t, "To Do", actual.Issues[0].Fields.Status.Name)
+
+	unexpectedStatusCode = true
+
+	_, err = client.BoardIssues(1, "assignee=currentUser()", 50, 50)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,208 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// CacheClassMeta are the endpoints that rarely change, eg: create metadata, fields, issue link types and statuses.
+	CacheClassMeta CacheClass = "meta"
+	// CacheClassProject are the endpoints to fetch projects, boards and board configurations.
+	CacheClassProject CacheClass = "project"
+	// CacheClassTransitions are the endpoints to fetch the transitions of an issue.
+	CacheClassTransitions CacheClass = "transitions"
+
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+var (
+	cacheRoutes = []struct {
+		class   CacheClass
+		pattern *regexp.Regexp
+	}{
+		{
+			class:   CacheClassMeta,
+			pattern: regexp.MustCompile(`^/rest/api/[23]/(issue/createmeta|issueLinkType|field|status|priority|resolution|issuetype)(/.*)?$`),
+		},
+		{
+			class:   CacheClassProject,
+			pattern: regexp.MustCompile(`^(/rest/api/[23]/project|/rest/agile/1\.0/board(/\d+/configuration)?)$`),
+		},
+		{
+			class:   CacheClassTransitions,
+			pattern: regexp.MustCompile(`^/rest/api/[23]/issue/[^/]+/transitions$`),
+		},
+	}
+
+	issuePath = regexp.MustCompile(`^/rest/api/[23]/issue/([^/]+)`)
+)
+
+// CacheClass is a group of endpoints that share the same time to live.
+type CacheClass string
+
+// CachedResponse is a response stored in the cache.
+type CachedResponse struct {
+	Status     string      `json:"status"`
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	StoredAt   time.Time   `json:"storedAt"`
+}
+
+// Cache stores the responses of the GET requests.
+type Cache interface {
+	// Get returns the cached response for the key, if any.
+	Get(key string) (*CachedResponse, bool)
+	// Set stores the response for the key.
+	Set(key string, res *CachedResponse) error
+	// Delete removes the cached response for the key.
+	Delete(key string) error
+}
+
+// CachePolicy configures how long the responses are used without reaching the server.
+//
+// A stale response is revalidated with the server using the If-None-Match header if
+// the server sent an ETag. The endpoints that don't belong to any class are never cached.
+type CachePolicy struct {
+	// TTL is the time to live per endpoint class. Zero disables caching for the class.
+	TTL map[CacheClass]time.Duration
+}
+
+// DefaultCachePolicy returns a cache policy with sane defaults.
+func DefaultCachePolicy() CachePolicy {
+	return CachePolicy{
+		TTL: map[CacheClass]time.Duration{
+			CacheClassMeta:        24 * time.Hour,
+			CacheClassProject:     time.Hour,
+			CacheClassTransitions: 5 * time.Minute,
+		},
+	}
+}
+
+// WithCache is a functional opt to cache the responses using the given cache and policy.
+func WithCache(cache Cache, p CachePolicy) ClientFunc {
+	return func(c *Client) {
+		c.cache = cache
+		c.cachePolicy = p
+	}
+}
+
+// doCached serves the GET requests from the cache based on the configured
+// cache policy, and invalidates the cached data of the modified issues.
+func (c *Client) doCached(ctx context.Context, httpClient *http.Client, req *http.Request) (*http.Response, error) {
+	path := strings.TrimPrefix(req.URL.Path, c.basePath())
+
+	if req.Method != http.MethodGet {
+		res, err := c.do(ctx, httpClient, req)
+		if err == nil && res.StatusCode < http.StatusMultipleChoices {
+			c.invalidate(path)
+		}
+		return res, err
+	}
+
+	ttl := c.cachePolicy.ttl(path)
+	if ttl <= 0 {
+		return c.do(ctx, httpClient, req)
+	}
+
+	key := c.cacheKey(req.URL.String())
+
+	cached, ok := c.cache.Get(key)
+	if ok && time.Since(cached.StoredAt) < ttl {
+		return cached.response(req), nil
+	}
+	if ok && cached.Header.Get(headerETag) != "" {
+		req.Header.Set(headerIfNoneMatch, cached.Header.Get(headerETag))
+	}
+
+	res, err := c.do(ctx, httpClient, req)
+	if err != nil {
+		return res, err
+	}
+
+	switch {
+	case ok && res.StatusCode == http.StatusNotModified:
+		_, _ = io.Copy(io.Discard, res.Body)
+		_ = res.Body.Close()
+
+		cached.StoredAt = time.Now()
+		_ = c.cache.Set(key, cached)
+
+		return cached.response(req), nil
+	case res.StatusCode == http.StatusOK:
+		body, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		res.Body = io.NopCloser(bytes.NewReader(body))
+
+		_ = c.cache.Set(key, &CachedResponse{
+			Status:     res.Status,
+			StatusCode: res.StatusCode,
+			Header:     res.Header.Clone(),
+			Body:       body,
+			StoredAt:   time.Now(),
+		})
+	}
+
+	return res, nil
+}
+
+// invalidate removes the cached transitions of the issue modified by the request.
+func (c *Client) invalidate(path string) {
+	m := issuePath.FindStringSubmatch(path)
+	if m == nil {
+		return
+	}
+	for _, base := range []string{baseURLv2, baseURLv3} {
+		_ = c.cache.Delete(c.cacheKey(fmt.Sprintf("%s%s/issue/%s/transitions", c.server, base, m[1])))
+	}
+}
+
+// cacheKey returns the key for the endpoint. The responses depend on the
+// permissions of the user, so the login is part of the key.
+func (c *Client) cacheKey(endpoint string) string {
+	return fmt.Sprintf("%s %s", c.login, endpoint)
+}
+
+// basePath returns the path of the server, if jira is installed in a sub-path, eg: /jira.
+func (c *Client) basePath() string {
+	u, err := url.Parse(c.server)
+	if err != nil {
+		return ""
+	}
+	return u.Path
+}
+
+func (p CachePolicy) ttl(path string) time.Duration {
+	for _, r := range cacheRoutes {
+		if r.pattern.MatchString(path) {
+			return p.TTL[r.class]
+		}
+	}
+	return 0
+}
+
+func (r *CachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        r.Status,
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,157 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryCache map[string]*CachedResponse
+
+func (m memoryCache) Get(key string) (*CachedResponse, bool) {
+	res, ok := m[key]
+	return res, ok
+}
+
+func (m memoryCache) Set(key string, res *CachedResponse) error {
+	m[key] = res
+	return nil
+}
+
+func (m memoryCache) Delete(key string) error {
+	delete(m, key)
+	return nil
+}
+
+func TestCacheServesFreshResponses(t *testing.T) {
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": "1", "name": "Open"}]`))
+	}))
+	defer server.Close()
+
+	cache := memoryCache{}
+	client := NewClient(Config{Server: server.URL, Login: "user"}, WithTimeout(3*time.Second), WithCache(cache, DefaultCachePolicy()))
+
+	for range 3 {
+		statuses, err := client.Statuses()
+		assert.NoError(t, err)
+		assert.Len(t, statuses, 1)
+		assert.Equal(t, "Open", statuses[0].Name)
+	}
+	assert.Equal(t, 1, requests)
+	assert.Contains(t, cache, "user "+server.URL+"/rest/api/2/status")
+
+	// Endpoints that don't belong to any class are not cached.
+	for range 2 {
+		res, err := client.GetV2(context.Background(), "/myself", nil)
+		assert.NoError(t, err)
+		_ = res.Body.Close()
+	}
+	assert.Equal(t, 3, requests)
+	assert.Len(t, cache, 1)
+}
+
+func TestCacheRevalidatesStaleResponses(t *testing.T) {
+	var requests, notModified int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"issueLinkTypes": [{"id": "1", "name": "Blocks"}]}`))
+	}))
+	defer server.Close()
+
+	cache := memoryCache{}
+	policy := CachePolicy{TTL: map[CacheClass]time.Duration{CacheClassMeta: time.Hour}}
+	client := NewClient(Config{Server: server.URL, Login: "user"}, WithTimeout(3*time.Second), WithCache(cache, policy))
+
+	_, err := client.GetIssueLinkTypes()
+	assert.NoError(t, err)
+
+	key := "user " + server.URL + "/rest/api/2/issueLinkType"
+	cache[key].StoredAt = time.Now().Add(-2 * time.Hour)
+
+	types, err := client.GetIssueLinkTypes()
+	assert.NoError(t, err)
+	assert.Len(t, types, 1)
+	assert.Equal(t, "Blocks", types[0].Name)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, notModified)
+	assert.WithinDuration(t, time.Now(), cache[key].StoredAt, time.Minute)
+}
+
+func TestCacheInvalidatesTransitionsOfModifiedIssue(t *testing.T) {
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = w.Write([]byte(`{"transitions": [{"id": "2", "name": "Done"}]}`))
+	}))
+	defer server.Close()
+
+	cache := memoryCache{}
+	client := NewClient(Config{Server: server.URL + "/", Login: "user"}, WithTimeout(3*time.Second), WithCache(cache, DefaultCachePolicy()))
+
+	for range 2 {
+		_, err := client.Transitions("TEST-1")
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, requests)
+
+	res, err := client.Post(context.Background(), "/issue/TEST-1/transitions", []byte(`{}`), nil)
+	assert.NoError(t, err)
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+	assert.Empty(t, cache)
+
+	_, err = client.Transitions("TEST-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, requests)
+}
+
+func TestCachePolicyTTL(t *testing.T) {
+	p := DefaultCachePolicy()
+
+	cases := []struct {
+		path     string
+		expected time.Duration
+	}{
+		{path: "/rest/api/2/issue/createmeta", expected: 24 * time.Hour},
+		{path: "/rest/api/2/issue/createmeta/TEST/issuetypes", expected: 24 * time.Hour},
+		{path: "/rest/api/2/issueLinkType", expected: 24 * time.Hour},
+		{path: "/rest/api/2/field", expected: 24 * time.Hour},
+		{path: "/rest/api/2/project", expected: time.Hour},
+		{path: "/rest/agile/1.0/board", expected: time.Hour},
+		{path: "/rest/agile/1.0/board/42/configuration", expected: time.Hour},
+		{path: "/rest/api/3/issue/TEST-1/transitions", expected: 5 * time.Minute},
+		{path: "/rest/api/3/issue/TEST-1", expected: 0},
+		{path: "/rest/api/2/project/TEST/versions", expected: 0},
+		{path: "/rest/agile/1.0/board/42/issue", expected: 0},
+		{path: "/rest/api/3/search/jql", expected: 0},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, p.ttl(tc.path), tc.path)
+	}
+}
@@ -0,0 +1,90 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetIssueChangelog fetches the change history of an issue using v3 version
+// of the GET /issue/{key}/changelog endpoint. The endpoint is only available
+// in the cloud installation.
+func (c *Client) GetIssueChangelog(key string, from, limit uint) (*ChangelogResult, error) {
+	path := fmt.Sprintf("/issue/%s/changelog?startAt=%d&maxResults=%d", key, from, limit)
+
+	res, err := c.Get(c.Context(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out ChangelogResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetAllIssueChangelog fetches complete change history of an issue by following the pagination.
+func (c *Client) GetAllIssueChangelog(key string) ([]*ChangelogHistory, error) {
+	const limit = 100
+
+	var (
+		from      uint
+		histories []*ChangelogHistory
+	)
+
+	for {
+		res, err := c.GetIssueChangelog(key, from, limit)
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, res.Histories...)
+
+		from += uint(len(res.Histories))
+		if res.IsLast || len(res.Histories) == 0 || int(from) >= res.Total {
+			break
+		}
+	}
+
+	return histories, nil
+}
+
+type issueChangelogV2 struct {
+	Changelog struct {
+		Histories []*ChangelogHistory `json:"histories"`
+	} `json:"changelog"`
+}
+
+// GetIssueChangelogV2 fetches the change history of an issue using v2 version of the
+// GET /issue/{key} endpoint with the changelog expanded. This is the only way to fetch
+// the changelog in a local installation.
+func (c *Client) GetIssueChangelogV2(key string) ([]*ChangelogHistory, error) {
+	path := fmt.Sprintf("/issue/%s?fields=summary&expand=changelog", key)
+
+	res, err := c.GetV2(c.Context(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out issueChangelogV2
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out.Changelog.Histories, err
+}
@@ -0,0 +1,153 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIssueChangelog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/TEST-1/changelog", r.URL.Path)
+
+		qs := r.URL.Query()
+		assert.Equal(t, "0", qs.Get("startAt"))
+		assert.Equal(t, "2", qs.Get("maxResults"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/changelog-0.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueChangelog("TEST-1", 0, 2)
+	assert.NoError(t, err)
+
+	expected := &ChangelogResult{
+		StartAt:    0,
+		MaxResults: 2,
+		Total:      3,
+		Histories: []*ChangelogHistory{
+			{
+				ID:      "10101",
+				Author:  User{AccountID: "a-b-c", DisplayName: "Person A", Active: true},
+				Created: "2022-01-03T09:30:00.000+0100",
+				Items: []*ChangelogItem{
+					{
+						Field:      "status",
+						FieldType:  "jira",
+						FieldID:    "status",
+						From:       "10000",
+						FromString: "To Do",
+						To:         "3",
+						ToString:   "In Progress",
+					},
+					{
+						Field:     "assignee",
+						FieldType: "jira",
+						FieldID:   "assignee",
+						To:        "a-b-c",
+						ToString:  "Person A",
+					},
+				},
+			},
+			{
+				ID:      "10102",
+				Author:  User{AccountID: "d-e-f", DisplayName: "Person B", Active: true},
+				Created: "2022-01-04T15:00:00.000+0100",
+				Items: []*ChangelogItem{
+					{
+						Field:      "Story Points",
+						FieldType:  "custom",
+						FieldID:    "customfield_10016",
+						FromString: "3",
+						ToString:   "5",
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueChangelog("TEST-1", 0, 2)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetAllIssueChangelog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/TEST-1/changelog", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("maxResults"))
+
+		resp, err := os.ReadFile(fmt.Sprintf("./testdata/changelog-%s.json", r.URL.Query().Get("startAt")))
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetAllIssueChangelog("TEST-1")
+	assert.NoError(t, err)
+
+	ids := make([]string, 0, len(actual))
+	for _, h := range actual {
+		ids = append(ids, h.ID)
+	}
+	assert.Equal(t, []string{"10101", "10102", "10103"}, ids)
+}
+
+func TestGetIssueChangelogV2(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "changelog", r.URL.Query().Get("expand"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"key": "TEST-1", "changelog": {"startAt": 0, "maxResults": 1, "total": 1, "histories": [
+			{"id": "10101", "author": {"name": "jon", "displayName": "Jon Doe"}, "created": "2022-01-03T09:30:00.000+0100",
+			"items": [{"field": "status", "fieldtype": "jira", "from": "1", "fromString": "Open", "to": "3", "toString": "In Progress"}]}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueChangelogV2("TEST-1")
+	assert.NoError(t, err)
+
+	expected := []*ChangelogHistory{{
+		ID:      "10101",
+		Author:  User{Name: "jon", DisplayName: "Jon Doe"},
+		Created: "2022-01-03T09:30:00.000+0100",
+		Items: []*ChangelogItem{{
+			Field:      "status",
+			FieldType:  "jira",
+			From:       "1",
+			FromString: "Open",
+			To:         "3",
+			ToString:   "In Progress",
+		}},
+	}}
+	assert.Equal(t, expected, actual)
+}
@@ -7,10 +7,10 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"net/http"
-	"net/http/httputil"
 	"os"
 	"strings"
 	"time"
@@ -116,14 +116,21 @@ type Config struct {
 
 // Client is a jira client.
 type Client struct {
-	transport http.RoundTripper
-	insecure  bool
-	server    string
-	login     string
-	authType  *AuthType
-	token     string
-	timeout   time.Duration
-	debug     bool
+	transport  http.RoundTripper
+	insecure   bool
+	server     string
+	login      string
+	authType   *AuthType
+	token      string
+	timeout    time.Duration
+	reqTimeout time.Duration
+	retry      *RetryPolicy
+	debug      bool
+	debugOut   io.Writer
+	ctx        context.Context
+
+	cache       Cache
+	cachePolicy CachePolicy
 }
 
 // ClientFunc decorates option for client.
@@ -187,6 +194,14 @@ func WithTimeout(to time.Duration) ClientFunc {
 	}
 }
 
+// WithRequestTimeout is a functional opt to limit the time each request may take, including
+// reading the response body. Each attempt of a retried request gets the full duration.
+func WithRequestTimeout(to time.Duration) ClientFunc {
+	return func(c *Client) {
+		c.reqTimeout = to
+	}
+}
+
 // WithInsecureTLS is a functional opt that allow you to skip TLS certificate verification.
 func WithInsecureTLS(ins bool) ClientFunc {
 	return func(c *Client) {
@@ -194,6 +209,31 @@ func WithInsecureTLS(ins bool) ClientFunc {
 	}
 }
 
+// WithContext returns a shallow copy of the client that uses the given context for all
+// the requests it makes. Cancelling the context aborts the in-flight requests. A nil
+// context is treated as the background context.
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//
+//	issue, err := client.WithContext(ctx).GetIssue("TEST-1")
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	c2 := *c
+	c2.ctx = ctx
+	return &c2
+}
+
+// Context returns the context used by the client. Defaults to the background context.
+func (c *Client) Context() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return context.Background()
+}
+
 // Get sends GET request to v3 version of the jira api.
 func (c *Client) Get(ctx context.Context, path string, headers Header) (*http.Response, error) {
 	return c.request(ctx, http.MethodGet, c.server+baseURLv3+path, nil, headers)
@@ -245,20 +285,26 @@ func (c *Client) DeleteV2(ctx context.Context, path string, headers Header) (*ht
 }
 
 func (c *Client) request(ctx context.Context, method, endpoint string, body []byte, headers Header) (*http.Response, error) {
+	return c.requestWithReader(ctx, method, endpoint, bytes.NewReader(body), headers)
+}
+
+// requestWithReader is same as request but accepts the body as a reader so
+// that large payloads, eg: attachments, can be streamed to the server.
+func (c *Client) requestWithReader(ctx context.Context, method, endpoint string, body io.Reader, headers Header) (*http.Response, error) {
 	var (
 		req *http.Request
 		res *http.Response
 		err error
 	)
 
-	req, err = http.NewRequest(method, endpoint, bytes.NewReader(body))
+	req, err = http.NewRequest(method, endpoint, body)
 	if err != nil {
 		return nil, err
 	}
 
 	defer func() {
 		if c.debug {
-			dump(req, res)
+			c.dump(req, res)
 		}
 	}()
 
@@ -285,27 +331,15 @@ func (c *Client) request(ctx context.Context, method, endpoint string, body []by
 		req.SetBasicAuth(c.login, c.token)
 	}
 
-	httpClient := &http.Client{Transport: c.transport}
-
-	return httpClient.Do(req.WithContext(ctx))
-}
-
-func dump(req *http.Request, res *http.Response) {
-	reqDump, _ := httputil.DumpRequest(req, true)
-	prettyPrintDump("Request Details", reqDump)
+	httpClient := &http.Client{Transport: c.transport, Timeout: c.reqTimeout}
 
-	if res != nil {
-		respDump, _ := httputil.DumpResponse(res, false)
-		prettyPrintDump("Response Details", respDump)
+	if c.cache != nil {
+		res, err = c.doCached(ctx, httpClient, req)
+	} else {
+		res, err = c.do(ctx, httpClient, req)
 	}
-}
-
-func prettyPrintDump(heading string, data []byte) {
-	const separatorWidth = 60
 
-	fmt.Printf("\n\n%s", strings.ToUpper(heading))
-	fmt.Printf("\n%s\n\n", strings.Repeat("-", separatorWidth))
-	fmt.Print(string(data))
+	return res, err
 }
 
 func formatUnexpectedResponse(res *http.Response) *ErrUnexpectedResponse {
@@ -207,3 +207,67 @@ func TestDeleteV2(t *testing.T) {
 
 	_ = resp.Body.Close()
 }
+
+func TestWithContext(t *testing.T) {
+	unblock := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-unblock:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+	assert.Equal(t, context.Background(), client.Context())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	cc := client.WithContext(ctx)
+	assert.Equal(t, ctx, cc.Context())
+	assert.Equal(t, context.Background(), client.Context())
+
+	_, err := cc.GetIssue("TEST-1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithContextNil(t *testing.T) {
+	client := NewClient(Config{Server: "http://localhost"})
+
+	//nolint:staticcheck
+	cc := client.WithContext(nil)
+	assert.Equal(t, context.Background(), cc.Context())
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/2/slow" {
+			select {
+			case <-unblock:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithRequestTimeout(50*time.Millisecond))
+
+	_, err := client.GetV2(context.Background(), "/slow", nil)
+	assert.Error(t, err)
+
+	// The timeout applies to each request, so the requests made afterward are not affected.
+	time.Sleep(60 * time.Millisecond)
+
+	res, err := client.GetV2(context.Background(), "/fast", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	_ = res.Body.Close()
+}
@@ -0,0 +1,144 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetIssueComments fetches comments of an issue using GET /issue/{key}/comment endpoint.
+func (c *Client) GetIssueComments(key string, from, limit uint) (*CommentResult, error) {
+	path := fmt.Sprintf("/issue/%s/comment?startAt=%d&maxResults=%d&orderBy=created", key, from, limit)
+
+	res, err := c.GetV2(c.Context(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out CommentResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetAllIssueComments fetches all comments of an issue by following the pagination.
+func (c *Client) GetAllIssueComments(key string) ([]*Comment, error) {
+	const limit = 100
+
+	var (
+		from     uint
+		comments []*Comment
+	)
+
+	for {
+		res, err := c.GetIssueComments(key, from, limit)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, res.Comments...)
+
+		from += uint(len(res.Comments))
+		if len(res.Comments) == 0 || int(from) >= res.Total {
+			break
+		}
+	}
+
+	return comments, nil
+}
+
+// GetIssueComment fetches a single comment using GET /issue/{key}/comment/{id} endpoint.
+func (c *Client) GetIssueComment(key, id string) (*Comment, error) {
+	res, err := c.GetV2(c.Context(), fmt.Sprintf("/issue/%s/comment/%s", key, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Comment
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// UpdateIssueComment updates a comment using v2 version of the PUT /issue/{key}/comment/{id} endpoint.
+func (c *Client) UpdateIssueComment(key, id, comment string) error {
+	return c.updateIssueComment(key, id, comment, apiVersion2)
+}
+
+// UpdateIssueCommentV3 updates a comment using v3 version of the PUT /issue/{key}/comment/{id}
+// endpoint. The comment is converted from markdown to an ADF document.
+func (c *Client) UpdateIssueCommentV3(key, id, comment string) error {
+	return c.updateIssueComment(key, id, comment, apiVersion3)
+}
+
+func (c *Client) updateIssueComment(key, id, comment, ver string) error {
+	body, err := json.Marshal(&struct {
+		Body interface{} `json:"body"`
+	}{Body: formatBody(comment, ver)})
+	if err != nil {
+		return err
+	}
+
+	header := Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	}
+
+	var res *http.Response
+
+	path := fmt.Sprintf("/issue/%s/comment/%s", key, id)
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.PutV2(c.Context(), path, body, header)
+	default:
+		res, err = c.Put(c.Context(), path, body, header)
+	}
+
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// DeleteIssueComment removes a comment using DELETE /issue/{key}/comment/{id} endpoint.
+func (c *Client) DeleteIssueComment(key, id string) error {
+	res, err := c.DeleteV2(c.Context(), fmt.Sprintf("/issue/%s/comment/%s", key, id), nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
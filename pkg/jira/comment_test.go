@@ -0,0 +1,190 @@
+package jira
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIssueComments(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment", r.URL.Path)
+
+		qs := r.URL.Query()
+		assert.Equal(t, "0", qs.Get("startAt"))
+		assert.Equal(t, "2", qs.Get("maxResults"))
+		assert.Equal(t, "created", qs.Get("orderBy"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/comments.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueComments("TEST-1", 0, 2)
+	assert.NoError(t, err)
+
+	personA := User{AccountID: "a-b-c", DisplayName: "Person A", Active: true}
+	personB := User{AccountID: "d-e-f", DisplayName: "Person B", Active: true}
+
+	expected := &CommentResult{
+		StartAt:    0,
+		MaxResults: 2,
+		Total:      3,
+		Comments: []*Comment{
+			{
+				ID:           "10100",
+				Author:       personA,
+				UpdateAuthor: personA,
+				Body:         "First comment",
+				Created:      "2020-12-03T14:05:20.974+0100",
+				Updated:      "2020-12-03T14:05:20.974+0100",
+			},
+			{
+				ID:           "10101",
+				Author:       personB,
+				UpdateAuthor: personA,
+				Body:         "Second comment with *bold* text",
+				Created:      "2020-12-04T10:15:00.000+0100",
+				Updated:      "2020-12-05T09:00:00.000+0100",
+			},
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueComments("TEST-1", 0, 2)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetAllIssueComments(t *testing.T) {
+	var calls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment", r.URL.Path)
+		calls++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			_, _ = w.Write([]byte(`{"startAt": 0, "total": 3, "comments": [{"id": "1"}, {"id": "2"}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"startAt": 2, "total": 3, "comments": [{"id": "3"}]}`))
+		default:
+			t.Fatalf("unexpected startAt: %s", r.URL.Query().Get("startAt"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetAllIssueComments("TEST-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []*Comment{{ID: "1"}, {ID: "2"}, {ID: "3"}}, actual)
+	assert.Equal(t, 2, calls)
+}
+
+func TestUpdateIssueComment(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment/10100", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var req struct {
+			Body string `json:"body"`
+		}
+		assert.NoError(t, json.Unmarshal(body, &req))
+		assert.Equal(t, "Updated *comment*\n\n", req.Body)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id": "10100"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.UpdateIssueComment("TEST-1", "10100", "Updated **comment**")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.UpdateIssueComment("TEST-1", "10100", "Updated **comment**")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateIssueCommentV3(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/TEST-1/comment/10100", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		expected := `{"body":{"version":1,"type":"doc","content":[{"type":"codeBlock","attrs":{"language":"go"},` +
+			`"content":[{"type":"text","text":"fmt.Println()"}]}]}}`
+		assert.JSONEq(t, expected, string(body))
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "10100"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.UpdateIssueCommentV3("TEST-1", "10100", "```go\nfmt.Println()\n```")
+	assert.NoError(t, err)
+}
+
+func TestDeleteIssueComment(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment/10100", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteIssueComment("TEST-1", "10100")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteIssueComment("TEST-1", "10100")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 	"strconv"
@@ -80,7 +79,7 @@ func (c *Client) CreateV2(req *CreateRequest) (*CreateResponse, error) {
 }
 
 func (c *Client) create(req *CreateRequest, ver string) (*CreateResponse, error) {
-	data := c.getRequestData(req)
+	data := c.getRequestData(req, ver)
 
 	body, err := json.Marshal(&data)
 	if err != nil {
@@ -96,9 +95,9 @@ func (c *Client) create(req *CreateRequest, ver string) (*CreateResponse, error)
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.PostV2(context.Background(), "/issue", body, header)
+		res, err = c.PostV2(c.Context(), "/issue", body, header)
 	default:
-		res, err = c.Post(context.Background(), "/issue", body, header)
+		res, err = c.Post(c.Context(), "/issue", body, header)
 	}
 
 	if err != nil {
@@ -120,7 +119,7 @@ func (c *Client) create(req *CreateRequest, ver string) (*CreateResponse, error)
 	return &out, err
 }
 
-func (*Client) getRequestData(req *CreateRequest) *createRequest {
+func (*Client) getRequestData(req *CreateRequest, ver string) *createRequest {
 	if req.Labels == nil {
 		req.Labels = []string{}
 	}
@@ -148,12 +147,7 @@ func (*Client) getRequestData(req *CreateRequest) *createRequest {
 		epicField: req.EpicField,
 	}
 
-	switch v := req.Body.(type) {
-	case string:
-		cf.Description = md.ToJiraMD(v)
-	case *adf.ADF:
-		cf.Description = v
-	}
+	cf.Description = formatBody(req.Body, ver)
 
 	data := createRequest{
 		Update: struct{}{},
@@ -236,12 +230,30 @@ func (*Client) getRequestData(req *CreateRequest) *createRequest {
 		}{OriginalEstimate: req.OriginalEstimate}
 	}
 
-	constructCustomFields(req.CustomFields, req.configuredCustomFields, &data)
+	constructCustomFields(req.CustomFields, req.configuredCustomFields, &data, ver)
 
 	return &data
 }
 
-func constructCustomFields(fields map[string]string, configuredFields []IssueTypeField, data *createRequest) {
+// formatBody converts a markdown body to the format accepted by the given API
+// version, ie: Jira wiki markup in v2 and an ADF document in v3.
+func formatBody(body interface{}, ver string) interface{} {
+	switch v := body.(type) {
+	case string:
+		if ver == apiVersion2 {
+			return md.ToJiraMD(v)
+		}
+		if v == "" {
+			return nil
+		}
+		return adf.FromMarkdown(v)
+	case *adf.ADF:
+		return v
+	}
+	return nil
+}
+
+func constructCustomFields(fields map[string]string, configuredFields []IssueTypeField, data *createRequest, ver string) {
 	if len(fields) == 0 || len(configuredFields) == 0 {
 		return
 	}
@@ -280,7 +292,11 @@ func constructCustomFields(fields map[string]string, configuredFields []IssueTyp
 					data.Fields.M.customFields[configured.Key] = customFieldTypeNumber(num)
 				}
 			default:
-				data.Fields.M.customFields[configured.Key] = val
+				if ver != apiVersion2 && configured.Schema.Custom == customFieldTypeTextarea {
+					data.Fields.M.customFields[configured.Key] = adf.FromMarkdown(val)
+				} else {
+					data.Fields.M.customFields[configured.Key] = val
+				}
 			}
 		}
 	}
@@ -178,3 +178,96 @@ func TestCreateEpicNextGen(t *testing.T) {
 	_, err = client.CreateV2(&requestData)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestCreateV3(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Bug"},"summary":"Test bug",` +
+			`"description":{"version":1,"type":"doc","content":[{"type":"heading","attrs":{"level":2},` +
+			`"content":[{"type":"text","text":"Steps"}]},{"type":"paragraph","content":[{"type":"mention",` +
+			`"attrs":{"id":"5b10ac8d82e05b22cc7d4ef5"}},{"type":"text","text":" to verify"}]}]}}}`
+
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		resp, err := os.ReadFile("./testdata/create.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	requestData := CreateRequest{
+		Project:   "TEST",
+		IssueType: "Bug",
+		Summary:   "Test bug",
+		Body:      "## Steps\n[~accountid:5b10ac8d82e05b22cc7d4ef5] to verify",
+	}
+	actual, err := client.Create(&requestData)
+	assert.NoError(t, err)
+
+	expected := &CreateResponse{
+		ID:  "10057",
+		Key: "TEST-3",
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestCreateV3WithRichTextCustomField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue", r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Bug"},"summary":"Test bug",` +
+			`"customfield_10100":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text",` +
+			`"text":"Fails on "},{"type":"text","text":"checkout","marks":[{"type":"strong"}]}]}]},"customfield_10101":"web"}}`
+
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		resp, err := os.ReadFile("./testdata/create.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	requestData := CreateRequest{
+		Project:   "TEST",
+		IssueType: "Bug",
+		Summary:   "Test bug",
+		CustomFields: map[string]string{
+			"steps":    "Fails on **checkout**",
+			"platform": "web",
+		},
+	}
+	requestData.WithCustomFields(testRichTextCustomFields())
+
+	_, err := client.Create(&requestData)
+	assert.NoError(t, err)
+}
+
+func testRichTextCustomFields() []IssueTypeField {
+	steps := IssueTypeField{Name: "Steps", Key: "customfield_10100"}
+	steps.Schema.DataType = "string"
+	steps.Schema.Custom = customFieldTypeTextarea
+
+	platform := IssueTypeField{Name: "Platform", Key: "customfield_10101"}
+	platform.Schema.DataType = "string"
+	platform.Schema.Custom = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+
+	return []IssueTypeField{steps, platform}
+}
@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -49,7 +48,7 @@ func (c *Client) GetCreateMeta(req *CreateMetaRequest) (*CreateMetaResponse, err
 		path += fmt.Sprintf("&issuetypeNames=%s", req.IssueTypeNames)
 	}
 
-	res, err := c.GetV2(context.Background(), path, nil)
+	res, err := c.GetV2(c.Context(), path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +78,7 @@ func (c *Client) GetCreateMetaForJiraServerV9(req *CreateMetaRequest) (*CreateMe
 		path += fmt.Sprintf("&issuetypeNames=%s", req.IssueTypeNames)
 	}
 
-	res, err := c.GetV2(context.Background(), path, nil)
+	res, err := c.GetV2(c.Context(), path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +98,60 @@ func (c *Client) GetCreateMetaForJiraServerV9(req *CreateMetaRequest) (*CreateMe
 	return &out, err
 }
 
+// CreateMetaFieldsResponseJiraServerV9 struct holds response from GET /issue/createmeta/{project}/issuetypes/{id}
+// endpoint for jira server 9 and above.
+type CreateMetaFieldsResponseJiraServerV9 struct {
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	Values     []IssueTypeField `json:"values"`
+}
+
+// GetCreateMetaFieldsForJiraServerV9 gets the create metadata of all fields of an issue type using
+// GET /issue/createmeta/{project}/issuetypes/{id} endpoint for jira server 9 and above.
+func (c *Client) GetCreateMetaFieldsForJiraServerV9(project, issueTypeID string) ([]IssueTypeField, error) {
+	const limit = 100
+
+	var (
+		from   int
+		fields []IssueTypeField
+	)
+
+	for {
+		path := fmt.Sprintf("/issue/createmeta/%s/issuetypes/%s?startAt=%d&maxResults=%d", project, issueTypeID, from, limit)
+
+		res, err := c.GetV2(c.Context(), path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return nil, ErrEmptyResponse
+		}
+
+		if res.StatusCode != http.StatusOK {
+			err := formatUnexpectedResponse(res)
+			_ = res.Body.Close()
+			return nil, err
+		}
+
+		var out CreateMetaFieldsResponseJiraServerV9
+
+		err = json.NewDecoder(res.Body).Decode(&out)
+		_ = res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, out.Values...)
+
+		from += len(out.Values)
+		if len(out.Values) == 0 || from >= out.Total {
+			break
+		}
+	}
+
+	return fields, nil
+}
+
 // GetIssueTypeFields retrieves available fields for a specific issue type in a project.
 // This is used to validate custom fields before creating an issue.
 func (c *Client) GetIssueTypeFields(project, issueTypeID string) ([]IssueTypeField, error) {
@@ -90,6 +90,43 @@ func TestGetCreateMeta(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestGetCreateMetaFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/createmeta", r.URL.Path)
+
+		resp, err := os.ReadFile("./testdata/createmetafields.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetCreateMeta(&CreateMetaRequest{
+		Projects: "TEST",
+		Expand:   "projects.issuetypes.fields",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, actual.Projects, 1)
+	assert.Len(t, actual.Projects[0].IssueTypes, 1)
+
+	fields := actual.Projects[0].IssueTypes[0].Fields
+	assert.Len(t, fields, 3)
+
+	assert.True(t, fields["summary"].Required)
+	assert.False(t, fields["summary"].HasDefaultValue)
+	assert.Nil(t, fields["summary"].AllowedValues)
+
+	assert.False(t, fields["priority"].Required)
+	assert.True(t, fields["priority"].HasDefaultValue)
+	assert.Equal(t, []*FieldAllowedValue{{ID: "1", Name: "High"}, {ID: "2", Name: "Low"}}, fields["priority"].AllowedValues)
+
+	assert.Equal(t, []*FieldAllowedValue{{ID: "10200", Value: "Web"}}, fields["customfield_10100"].AllowedValues)
+}
+
 func TestGetCreateMetaForJiraServerV9(t *testing.T) {
 	var unexpectedStatusCode bool
 
@@ -154,3 +191,53 @@ func TestGetCreateMetaForJiraServerV9(t *testing.T) {
 	})
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestGetCreateMetaFieldsForJiraServerV9(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/createmeta/TEST/issuetypes/10002", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		file := "./testdata/createmetav9fields.json"
+		if r.URL.Query().Get("startAt") == "2" {
+			file = "./testdata/createmetav9fields_page2.json"
+		}
+
+		resp, err := os.ReadFile(file)
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetCreateMetaFieldsForJiraServerV9("TEST", "10002")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 3)
+
+	assert.Equal(t, "summary", actual[0].FieldID)
+	assert.True(t, actual[0].Required)
+	assert.False(t, actual[0].HasDefaultValue)
+
+	assert.Equal(t, "priority", actual[1].FieldID)
+	assert.True(t, actual[1].HasDefaultValue)
+	assert.Equal(t, []*FieldAllowedValue{{ID: "1", Name: "High"}, {ID: "2", Name: "Low"}}, actual[1].AllowedValues)
+
+	assert.Equal(t, "customfield_10100", actual[2].FieldID)
+	assert.Equal(t, "array", actual[2].Schema.DataType)
+	assert.Equal(t, "option", actual[2].Schema.Items)
+	assert.Equal(t, []*FieldAllowedValue{{ID: "10200", Value: "Web"}}, actual[2].AllowedValues)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetCreateMetaFieldsForJiraServerV9("TEST", "10002")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -1,10 +1,27 @@
 package jira
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+)
+
 const (
+	customFieldPrefix = "customfield_"
+
 	customFieldFormatOption  = "option"
 	customFieldFormatArray   = "array"
 	customFieldFormatNumber  = "number"
 	customFieldFormatProject = "project"
+
+	// customFieldTypeTextarea is the schema type of the multi-line text custom fields
+	// that accept a rich text value, ie: an ADF document in v3.
+	customFieldTypeTextarea = "com.atlassian.jira.plugin.system.customfieldtypes:textarea"
 )
 
 type customField map[string]interface{}
@@ -19,6 +36,10 @@ type customFieldTypeStringSet struct {
 	Set string `json:"set"`
 }
 
+type customFieldTypeADFSet struct {
+	Set *adf.ADF `json:"set"`
+}
+
 type customFieldTypeOption struct {
 	Value string `json:"value"`
 }
@@ -39,3 +60,105 @@ type customFieldTypeProject struct {
 type customFieldTypeProjectSet struct {
 	Set customFieldTypeProject `json:"set"`
 }
+
+// UnmarshalJSON unmarshals issue fields and keeps the raw values of the custom fields.
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	type alias IssueFields
+
+	var fields alias
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for k, v := range raw {
+		if !strings.HasPrefix(k, customFieldPrefix) || string(v) == "null" {
+			continue
+		}
+		if fields.CustomFields == nil {
+			fields.CustomFields = make(map[string]json.RawMessage)
+		}
+		fields.CustomFields[k] = v
+	}
+
+	*f = IssueFields(fields)
+
+	return nil
+}
+
+// MarshalJSON marshals issue fields along with the raw values of the custom fields.
+func (f IssueFields) MarshalJSON() ([]byte, error) {
+	type alias IssueFields
+
+	data, err := json.Marshal(alias(f))
+	if err != nil || len(f.CustomFields) == 0 {
+		return data, err
+	}
+
+	keys := make([]string, 0, len(f.CustomFields))
+	for k := range f.CustomFields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// Custom fields are appended to the end of the object to keep the order of other fields.
+	buf := bytes.NewBuffer(data[:len(data)-1])
+	for _, k := range keys {
+		buf.WriteString(fmt.Sprintf(",%q:", k))
+		if err := json.Compact(buf, f.CustomFields[k]); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// CustomFieldValue returns a human readable value of a raw custom field value. Values of
+// the option and user fields are flattened and array values are joined by a comma.
+func CustomFieldValue(raw json.RawMessage) string {
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return string(raw)
+	}
+	return customFieldString(v)
+}
+
+func customFieldString(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	case []interface{}:
+		out := make([]string, 0, len(val))
+		for _, item := range val {
+			if s := customFieldString(item); s != "" {
+				out = append(out, s)
+			}
+		}
+		return strings.Join(out, ", ")
+	case map[string]interface{}:
+		for _, k := range []string{"value", "displayName", "name", "key"} {
+			if s, ok := val[k].(string); ok {
+				if child, ok := val["child"]; ok {
+					return fmt.Sprintf("%s > %s", s, customFieldString(child))
+				}
+				return s
+			}
+		}
+		b, err := json.Marshal(val)
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,83 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueFieldsUnmarshalJSON(t *testing.T) {
+	data := `{
+		"summary": "Issue with custom fields",
+		"status": {"name": "Done"},
+		"customfield_10016": 5,
+		"customfield_10020": {"value": "Production"},
+		"customfield_10030": null
+	}`
+
+	var fields IssueFields
+	assert.NoError(t, json.Unmarshal([]byte(data), &fields))
+
+	assert.Equal(t, "Issue with custom fields", fields.Summary)
+	assert.Equal(t, "Done", fields.Status.Name)
+	assert.Equal(t, map[string]json.RawMessage{
+		"customfield_10016": json.RawMessage(`5`),
+		"customfield_10020": json.RawMessage(`{"value": "Production"}`),
+	}, fields.CustomFields)
+
+	var noCustomFields IssueFields
+	assert.NoError(t, json.Unmarshal([]byte(`{"summary": "Test"}`), &noCustomFields))
+	assert.Nil(t, noCustomFields.CustomFields)
+}
+
+func TestIssueFieldsMarshalJSON(t *testing.T) {
+	fields := IssueFields{
+		Summary: "Issue with custom fields",
+		CustomFields: map[string]json.RawMessage{
+			"customfield_10020": json.RawMessage(`{"value": "Production"}`),
+			"customfield_10016": json.RawMessage(`5`),
+		},
+	}
+
+	data, err := json.Marshal(Issue{Key: "TEST-1", Fields: fields})
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"updated":"","customfield_10016":5,"customfield_10020":{"value":"Production"}}}`)
+
+	var iss Issue
+	assert.NoError(t, json.Unmarshal(data, &iss))
+	assert.Equal(t, "Issue with custom fields", iss.Fields.Summary)
+	assert.Equal(t, map[string]json.RawMessage{
+		"customfield_10016": json.RawMessage(`5`),
+		"customfield_10020": json.RawMessage(`{"value":"Production"}`),
+	}, iss.Fields.CustomFields)
+
+	data, err = json.Marshal(IssueFields{Summary: "Test"})
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), customFieldPrefix)
+}
+
+func TestCustomFieldValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{name: "null", raw: `null`, expected: ""},
+		{name: "string", raw: `"production"`, expected: "production"},
+		{name: "integer", raw: `5`, expected: "5"},
+		{name: "float", raw: `2.5`, expected: "2.5"},
+		{name: "bool", raw: `true`, expected: "true"},
+		{name: "option", raw: `{"id": "1", "value": "High"}`, expected: "High"},
+		{name: "user", raw: `{"accountId": "a1", "displayName": "Jon Doe"}`, expected: "Jon Doe"},
+		{name: "cascading option", raw: `{"value": "EU", "child": {"value": "Berlin"}}`, expected: "EU > Berlin"},
+		{name: "array", raw: `[{"value": "A"}, {"value": "B"}, "C"]`, expected: "A, B, C"},
+		{name: "unknown object", raw: `{"id": 1}`, expected: `{"id":1}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, CustomFieldValue(json.RawMessage(tc.raw)))
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package jira
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"strings"
+)
+
+const (
+	// maxDumpBodySize is the number of body bytes included in the debug dump.
+	maxDumpBodySize = 8 << 10
+
+	redacted = "REDACTED"
+)
+
+var (
+	sensitiveHeaders = []string{
+		"Authorization",
+		"Proxy-Authorization",
+		"Cookie",
+		"Set-Cookie",
+		"X-Api-Key",
+		"X-Auth-Token",
+	}
+
+	sensitiveParams = []string{
+		"token",
+		"password",
+		"passwd",
+		"secret",
+		"apikey",
+		"api_key",
+		"signature",
+		"jwt",
+	}
+)
+
+// WithDebugWriter is a functional opt to write debug dumps to the given writer
+// instead of the standard output.
+func WithDebugWriter(w io.Writer) ClientFunc {
+	return func(c *Client) {
+		c.debugOut = w
+	}
+}
+
+// dump writes the request and response details to the debug writer. Credentials
+// are masked before writing, and only the first few KBs of the body are included.
+func (c *Client) dump(req *http.Request, res *http.Response) {
+	w := c.debugOut
+	if w == nil {
+		w = os.Stdout
+	}
+
+	reqDump, _ := httputil.DumpRequest(redactRequest(req), false)
+	// Streamed bodies cannot be replayed, so we will only dump the headers for those.
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			reqDump = append(reqDump, dumpBody(req.Header, body)...)
+			_ = body.Close()
+		}
+	}
+	prettyPrintDump(w, "Request Details", reqDump)
+
+	if res == nil {
+		return
+	}
+
+	respDump, _ := httputil.DumpResponse(redactResponse(res), false)
+	if res.Body != nil && res.Body != http.NoBody {
+		// Read the dumped part of the body upfront and stitch it back so
+		// that the caller still gets the complete response body.
+		buf, _ := io.ReadAll(io.LimitReader(res.Body, maxDumpBodySize+1))
+		res.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(buf), res.Body), res.Body}
+
+		respDump = append(respDump, dumpBody(res.Header, bytes.NewReader(buf))...)
+	}
+	prettyPrintDump(w, "Response Details", respDump)
+}
+
+func prettyPrintDump(w io.Writer, heading string, data []byte) {
+	const separatorWidth = 60
+
+	_, _ = fmt.Fprintf(w, "\n\n%s", strings.ToUpper(heading))
+	_, _ = fmt.Fprintf(w, "\n%s\n\n", strings.Repeat("-", separatorWidth))
+	_, _ = fmt.Fprint(w, string(data))
+}
+
+// dumpBody returns at most maxDumpBodySize bytes of the body. Binary
+// content, eg: attachments, is omitted from the dump.
+func dumpBody(h http.Header, body io.Reader) []byte {
+	if !isTextContent(h.Get("Content-Type")) {
+		return []byte("[binary body omitted]\n")
+	}
+
+	buf, _ := io.ReadAll(io.LimitReader(body, maxDumpBodySize+1))
+	if len(buf) == 0 {
+		return nil
+	}
+	if len(buf) > maxDumpBodySize {
+		return fmt.Appendf(buf[:maxDumpBodySize], "\n\n[body truncated after %d bytes]\n", maxDumpBodySize)
+	}
+	return append(buf, '\n')
+}
+
+func isTextContent(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mt, "text/") ||
+		strings.HasSuffix(mt, "json") ||
+		strings.HasSuffix(mt, "xml") ||
+		mt == "application/x-www-form-urlencoded"
+}
+
+// redactRequest returns a copy of the request with credentials
+// masked in the headers and the query string.
+func redactRequest(req *http.Request) *http.Request {
+	r := req.Clone(req.Context())
+	r.Body = nil
+	r.Header = redactHeader(req.Header)
+
+	if r.URL != nil && r.URL.RawQuery != "" {
+		q := r.URL.Query()
+		for k := range q {
+			if isSensitiveParam(k) {
+				q.Set(k, redacted)
+			}
+		}
+		r.URL.RawQuery = q.Encode()
+	}
+
+	return r
+}
+
+// redactResponse returns a shallow copy of the response with credentials masked in the headers.
+func redactResponse(res *http.Response) *http.Response {
+	r := *res
+	r.Body = nil
+	r.Header = redactHeader(res.Header)
+	return &r
+}
+
+func redactHeader(h http.Header) http.Header {
+	out := h.Clone()
+	for _, k := range sensitiveHeaders {
+		vals := out.Values(k)
+		if len(vals) == 0 {
+			continue
+		}
+		masked := make([]string, 0, len(vals))
+		for _, v := range vals {
+			masked = append(masked, redactHeaderValue(k, v))
+		}
+		out[http.CanonicalHeaderKey(k)] = masked
+	}
+	return out
+}
+
+// redactHeaderValue masks the header value while keeping the parts that
+// are useful for debugging, ie: the auth scheme and the cookie names.
+func redactHeaderValue(key, val string) string {
+	switch http.CanonicalHeaderKey(key) {
+	case "Authorization", "Proxy-Authorization":
+		if scheme, _, ok := strings.Cut(val, " "); ok {
+			return scheme + " " + redacted
+		}
+	case "Cookie":
+		cookies := strings.Split(val, ";")
+		for i, c := range cookies {
+			name, _, _ := strings.Cut(strings.TrimSpace(c), "=")
+			cookies[i] = name + "=" + redacted
+		}
+		return strings.Join(cookies, "; ")
+	case "Set-Cookie":
+		name, _, _ := strings.Cut(val, "=")
+		return name + "=" + redacted
+	}
+	return redacted
+}
+
+func isSensitiveParam(key string) bool {
+	key = strings.ToLower(key)
+	for _, p := range sensitiveParams {
+		if strings.Contains(key, p) {
+			return true
+		}
+	}
+	return false
+}
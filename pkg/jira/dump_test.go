@@ -0,0 +1,119 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDumpRedactsCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "atlassian.xsrf.token=secret-xsrf; Path=/")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"key":"TEST-1"}`))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+
+	client := NewClient(
+		Config{Server: server.URL, Login: "jon@doe.com", APIToken: "secret-token", Debug: true},
+		WithTimeout(3*time.Second),
+		WithDebugWriter(&out),
+	)
+
+	res, err := client.GetV2(context.Background(), "/issue/TEST-1?jwt=secret-jwt&access_token=secret-access&fields=summary", Header{
+		"Cookie": "JSESSIONID=secret-session; other=secret-other",
+	})
+	assert.NoError(t, err)
+
+	// The caller still receives the complete body.
+	body, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"key":"TEST-1"}`, string(body))
+	_ = res.Body.Close()
+
+	dump := out.String()
+
+	assert.NotContains(t, dump, "secret")
+	assert.Contains(t, dump, "REQUEST DETAILS")
+	assert.Contains(t, dump, "RESPONSE DETAILS")
+	assert.Contains(t, dump, "Authorization: Basic REDACTED")
+	assert.Contains(t, dump, "Cookie: JSESSIONID=REDACTED; other=REDACTED")
+	assert.Contains(t, dump, "Set-Cookie: atlassian.xsrf.token=REDACTED")
+	assert.Contains(t, dump, "fields=summary")
+	assert.Contains(t, dump, "jwt=REDACTED")
+	assert.Contains(t, dump, "access_token=REDACTED")
+	assert.Contains(t, dump, `{"key":"TEST-1"}`)
+}
+
+func TestDumpTruncatesLargeBody(t *testing.T) {
+	payload := strings.Repeat("a", maxDumpBodySize+100)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(payload))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+
+	client := NewClient(Config{Server: server.URL, Debug: true}, WithTimeout(3*time.Second), WithDebugWriter(&out))
+
+	res, err := client.GetV2(context.Background(), "/issue/TEST-1", nil)
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, string(body))
+	_ = res.Body.Close()
+
+	assert.Contains(t, out.String(), "[body truncated after 8192 bytes]")
+	assert.NotContains(t, out.String(), payload)
+}
+
+func TestDumpOmitsBinaryBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte("\x89PNG"))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+
+	client := NewClient(Config{Server: server.URL, Debug: true}, WithTimeout(3*time.Second), WithDebugWriter(&out))
+
+	res, err := client.GetV2(context.Background(), "/attachment/content/1", nil)
+	assert.NoError(t, err)
+	_ = res.Body.Close()
+
+	assert.Contains(t, out.String(), "[binary body omitted]")
+	assert.NotContains(t, out.String(), "PNG")
+}
+
+func TestRedactHeaderValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		key, val string
+		expected string
+	}{
+		{"bearer token", "Authorization", "Bearer abc123", "Bearer REDACTED"},
+		{"token without scheme", "Authorization", "abc123", "REDACTED"},
+		{"api key", "X-Api-Key", "abc123", "REDACTED"},
+		{"single cookie", "Cookie", "JSESSIONID=abc123", "JSESSIONID=REDACTED"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, redactHeaderValue(tc.key, tc.val))
+		})
+	}
+}
@@ -1,13 +1,14 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"maps"
 	"net/http"
 	"slices"
 	"strconv"
 	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
 )
 
 const separatorMinus = "-"
@@ -43,9 +44,20 @@ func (er *EditRequest) WithCustomFields(cf []IssueTypeField) {
 	er.configuredCustomFields = cf
 }
 
-// Edit updates an issue using POST /issue endpoint.
+// Edit updates an issue using v2 version of the PUT /issue/{key} endpoint.
+// The body is expected to be in Jira wiki markup format.
 func (c *Client) Edit(key string, req *EditRequest) error {
-	data := getRequestDataForEdit(req)
+	return c.edit(key, req, apiVersion2)
+}
+
+// EditV3 updates an issue using v3 version of the PUT /issue/{key} endpoint.
+// The body is expected to be in markdown format and is sent as an ADF document.
+func (c *Client) EditV3(key string, req *EditRequest) error {
+	return c.edit(key, req, apiVersion3)
+}
+
+func (c *Client) edit(key string, req *EditRequest, ver string) error {
+	data := getRequestDataForEdit(req, ver)
 
 	body, err := json.Marshal(&data)
 	if err != nil {
@@ -57,10 +69,20 @@ func (c *Client) Edit(key string, req *EditRequest) error {
 		endpoint += "?notifyUsers=false"
 	}
 
-	res, err := c.PutV2(context.Background(), endpoint, body, Header{
+	header := Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
-	})
+	}
+
+	var res *http.Response
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.PutV2(c.Context(), endpoint, body, header)
+	default:
+		res, err = c.Put(c.Context(), endpoint, body, header)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -81,7 +103,7 @@ type editFields struct {
 		Set string `json:"set,omitempty"`
 	} `json:"summary,omitempty"`
 	Description []struct {
-		Set string `json:"set,omitempty"`
+		Set interface{} `json:"set,omitempty"`
 	} `json:"description,omitempty"`
 	Priority []struct {
 		Set struct {
@@ -129,7 +151,7 @@ func (cfm *editFieldsMarshaler) MarshalJSON() ([]byte, error) {
 	if len(cfm.M.Summary) == 0 || cfm.M.Summary[0].Set == "" {
 		cfm.M.Summary = nil
 	}
-	if len(cfm.M.Description) == 0 || cfm.M.Description[0].Set == "" {
+	if len(cfm.M.Description) == 0 || cfm.M.Description[0].Set == nil {
 		cfm.M.Description = nil
 	}
 	if len(cfm.M.Priority) == 0 || cfm.M.Priority[0].Set.Name == "" {
@@ -167,7 +189,7 @@ type editRequest struct {
 	} `json:"fields"`
 }
 
-func getRequestDataForEdit(req *EditRequest) *editRequest {
+func getRequestDataForEdit(req *EditRequest, ver string) *editRequest {
 	if req.Labels == nil {
 		req.Labels = []string{}
 	}
@@ -177,8 +199,8 @@ func getRequestDataForEdit(req *EditRequest) *editRequest {
 			Set string `json:"set,omitempty"`
 		}{{Set: req.Summary}},
 		Description: []struct {
-			Set string `json:"set,omitempty"`
-		}{{Set: req.Body}},
+			Set interface{} `json:"set,omitempty"`
+		}{{Set: editDescription(req.Body, ver)}},
 		Priority: []struct {
 			Set struct {
 				Name string `json:"name,omitempty"`
@@ -353,12 +375,24 @@ func getRequestDataForEdit(req *EditRequest) *editRequest {
 		Update: update,
 		Fields: fields,
 	}
-	constructCustomFieldsForEdit(req.CustomFields, req.configuredCustomFields, &data)
+	constructCustomFieldsForEdit(req.CustomFields, req.configuredCustomFields, &data, ver)
 
 	return &data
 }
 
-func constructCustomFieldsForEdit(fields map[string]string, configuredFields []IssueTypeField, data *editRequest) {
+// editDescription returns the description to set. The body is sent as is in v2
+// since the caller is expected to convert it to Jira wiki markup beforehand.
+func editDescription(body, ver string) interface{} {
+	if body == "" {
+		return nil
+	}
+	if ver == apiVersion2 {
+		return body
+	}
+	return adf.FromMarkdown(body)
+}
+
+func constructCustomFieldsForEdit(fields map[string]string, configuredFields []IssueTypeField, data *editRequest, ver string) {
 	if len(fields) == 0 || len(configuredFields) == 0 {
 		return
 	}
@@ -401,7 +435,11 @@ func constructCustomFieldsForEdit(fields map[string]string, configuredFields []I
 					data.Update.M.customFields[configured.Key] = []customFieldTypeNumberSet{{Set: customFieldTypeNumber(num)}}
 				}
 			default:
-				data.Update.M.customFields[configured.Key] = []customFieldTypeStringSet{{Set: val}}
+				if ver != apiVersion2 && configured.Schema.Custom == customFieldTypeTextarea {
+					data.Update.M.customFields[configured.Key] = []customFieldTypeADFSet{{Set: adf.FromMarkdown(val)}}
+				} else {
+					data.Update.M.customFields[configured.Key] = []customFieldTypeStringSet{{Set: val}}
+				}
 			}
 		}
 	}
@@ -0,0 +1,107 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type editTestServer struct {
+	path string
+	code int
+}
+
+func (e *editTestServer) serve(t *testing.T, expectedBody string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, e.path, r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(e.code)
+	}))
+}
+
+func TestEdit(t *testing.T) {
+	expectedBody := `{"update":{"summary":[{"set":"New summary"}],"description":[{"set":"h2. Steps"}]},"fields":{"parent":{}}}`
+
+	testServer := editTestServer{path: "/rest/api/2/issue/TEST-1", code: 204}
+	server := testServer.serve(t, expectedBody)
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.Edit("TEST-1", &EditRequest{Summary: "New summary", Body: "h2. Steps"})
+	assert.NoError(t, err)
+
+	testServer.code = 400
+
+	err = client.Edit("TEST-1", &EditRequest{Summary: "New summary", Body: "h2. Steps"})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestEditV3(t *testing.T) {
+	expectedBody := `{"update":{"summary":[{"set":"New summary"}],"description":[{"set":{"version":1,"type":"doc",` +
+		`"content":[{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Steps"}]}]}}]},"fields":{"parent":{}}}`
+
+	testServer := editTestServer{path: "/rest/api/3/issue/TEST-1", code: 204}
+	server := testServer.serve(t, expectedBody)
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.EditV3("TEST-1", &EditRequest{Summary: "New summary", Body: "## Steps"})
+	assert.NoError(t, err)
+
+	// Description is not updated if the body is empty.
+	server.Close()
+
+	testServer.path = "/rest/api/3/issue/TEST-2"
+	server = testServer.serve(t, `{"update":{"summary":[{"set":"New summary"}]},"fields":{"parent":{}}}`)
+	defer server.Close()
+
+	client = NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err = client.EditV3("TEST-2", &EditRequest{Summary: "New summary"})
+	assert.NoError(t, err)
+}
+
+func TestEditWithRichTextCustomField(t *testing.T) {
+	expectedBody := `{"update":{"customfield_10100":[{"set":{"version":1,"type":"doc","content":[{"type":"paragraph",` +
+		`"content":[{"type":"text","text":"Fails on "},{"type":"text","text":"checkout","marks":[{"type":"strong"}]}]}]}}],` +
+		`"customfield_10101":[{"set":"web"}]},"fields":{"parent":{}}}`
+
+	testServer := editTestServer{path: "/rest/api/3/issue/TEST-1", code: 204}
+	server := testServer.serve(t, expectedBody)
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	req := EditRequest{CustomFields: map[string]string{"steps": "Fails on **checkout**", "platform": "web"}}
+	req.WithCustomFields(testRichTextCustomFields())
+
+	err := client.EditV3("TEST-1", &req)
+	assert.NoError(t, err)
+
+	// Rich text fields are sent as is in v2.
+	server.Close()
+
+	testServer.path = "/rest/api/2/issue/TEST-1"
+	server = testServer.serve(t, `{"update":{"customfield_10100":[{"set":"Fails on **checkout**"}],"customfield_10101":[{"set":"web"}]},"fields":{"parent":{}}}`)
+	defer server.Close()
+
+	client = NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err = client.Edit("TEST-1", &req)
+	assert.NoError(t, err)
+}
@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -22,7 +21,7 @@ func (c *Client) EpicIssues(key, jql string, from, limit uint) (*SearchResult, e
 		path += fmt.Sprintf("&jql=%s", url.QueryEscape(jql))
 	}
 
-	res, err := c.GetV1(context.Background(), path, nil)
+	res, err := c.GetV1(c.Context(), path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +54,7 @@ func (c *Client) EpicIssuesAdd(key string, issues ...string) error {
 		return err
 	}
 
-	res, err := c.PostV1(context.Background(), path, body, Header{
+	res, err := c.PostV1(c.Context(), path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -86,7 +85,7 @@ func (c *Client) EpicIssuesRemove(issues ...string) error {
 		return err
 	}
 
-	res, err := c.PostV1(context.Background(), path, body, Header{
+	res, err := c.PostV1(c.Context(), path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
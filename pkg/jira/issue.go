@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -86,9 +85,9 @@ func (c *Client) getIssueRaw(key, ver string) (string, error) {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(c.Context(), path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(c.Context(), path, nil)
 	}
 
 	if err != nil {
@@ -150,7 +149,7 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 		if err != nil {
 			return err
 		}
-		res, err = c.PutV2(context.Background(), path, body, Header{
+		res, err = c.PutV2(c.Context(), path, body, Header{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		})
@@ -163,7 +162,7 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 		if err != nil {
 			return err
 		}
-		res, err = c.Put(context.Background(), path, body, Header{
+		res, err = c.Put(c.Context(), path, body, Header{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		})
@@ -185,7 +184,7 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 
 // GetIssueLinkTypes fetches issue link types using GET /issueLinkType endpoint.
 func (c *Client) GetIssueLinkTypes() ([]*IssueLinkType, error) {
-	res, err := c.GetV2(context.Background(), "/issueLinkType", nil)
+	res, err := c.GetV2(c.Context(), "/issueLinkType", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +237,7 @@ func (c *Client) LinkIssue(inwardIssue, outwardIssue, linkType string) error {
 		return err
 	}
 
-	res, err := c.PostV2(context.Background(), "/issueLink", body, Header{
+	res, err := c.PostV2(c.Context(), "/issueLink", body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -259,7 +258,7 @@ func (c *Client) LinkIssue(inwardIssue, outwardIssue, linkType string) error {
 // UnlinkIssue disconnects two issues using DELETE /issueLink/{linkId} endpoint.
 func (c *Client) UnlinkIssue(linkID string) error {
 	deleteLinkURL := fmt.Sprintf("/issueLink/%s", linkID)
-	res, err := c.DeleteV2(context.Background(), deleteLinkURL, Header{
+	res, err := c.DeleteV2(c.Context(), deleteLinkURL, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -305,22 +304,43 @@ type issueCommentProperty struct {
 	Value issueCommentPropertyValue `json:"value"`
 }
 type issueCommentRequest struct {
-	Body       string                 `json:"body"`
+	Body       interface{}            `json:"body"`
 	Properties []issueCommentProperty `json:"properties"`
 }
 
-// AddIssueComment adds comment to an issue using POST /issue/{key}/comment endpoint.
+// AddIssueComment adds comment to an issue using v2 version of the POST /issue/{key}/comment endpoint.
 func (c *Client) AddIssueComment(key, comment string, internal bool) error {
-	body, err := json.Marshal(&issueCommentRequest{Body: md.ToJiraMD(comment), Properties: []issueCommentProperty{{Key: "sd.public.comment", Value: issueCommentPropertyValue{Internal: internal}}}})
+	return c.addIssueComment(key, comment, internal, apiVersion2)
+}
+
+// AddIssueCommentV3 adds comment to an issue using v3 version of the POST /issue/{key}/comment
+// endpoint. The comment is converted from markdown to an ADF document.
+func (c *Client) AddIssueCommentV3(key, comment string, internal bool) error {
+	return c.addIssueComment(key, comment, internal, apiVersion3)
+}
+
+func (c *Client) addIssueComment(key, comment string, internal bool, ver string) error {
+	body, err := json.Marshal(&issueCommentRequest{Body: formatBody(comment, ver), Properties: []issueCommentProperty{{Key: "sd.public.comment", Value: issueCommentPropertyValue{Internal: internal}}}})
 	if err != nil {
 		return err
 	}
 
-	path := fmt.Sprintf("/issue/%s/comment", key)
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	header := Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
-	})
+	}
+
+	var res *http.Response
+
+	path := fmt.Sprintf("/issue/%s/comment", key)
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.PostV2(c.Context(), path, body, header)
+	default:
+		res, err = c.Post(c.Context(), path, body, header)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -360,7 +380,7 @@ func (c *Client) AddIssueWorklog(key, started, timeSpent, comment, newEstimate s
 	if newEstimate != "" {
 		path = fmt.Sprintf("%s?adjustEstimate=new&newEstimate=%s", path, newEstimate)
 	}
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	res, err := c.PostV2(c.Context(), path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -380,7 +400,7 @@ func (c *Client) AddIssueWorklog(key, started, timeSpent, comment, newEstimate s
 
 // GetField gets all fields configured for a Jira instance using GET /field endpiont.
 func (c *Client) GetField() ([]*Field, error) {
-	res, err := c.GetV2(context.Background(), "/field", Header{
+	res, err := c.GetV2(c.Context(), "/field", Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -442,7 +462,7 @@ func (c *Client) RemoteLinkIssue(issueID, title, url string) error {
 
 	path := fmt.Sprintf("/issue/%s/remotelink", issueID)
 
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	res, err := c.PostV2(c.Context(), path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -491,9 +511,9 @@ func (c *Client) watchIssue(key, watcher, ver string) error {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.PostV2(context.Background(), path, body, header)
+		res, err = c.PostV2(c.Context(), path, body, header)
 	default:
-		res, err = c.Post(context.Background(), path, body, header)
+		res, err = c.Post(c.Context(), path, body, header)
 	}
 
 	if err != nil {
@@ -554,6 +554,30 @@ func TestAddIssueComment(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestAddIssueCommentV3(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/rest/api/3/issue/TEST-1/comment", r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"body":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[` +
+			`{"type":"text","text":"A "},{"type":"text","text":"comment","marks":[{"type":"strong"}]}]}]},` +
+			`"properties":[{"key":"sd.public.comment","value":{"internal":true}}]}`
+
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(201)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.AddIssueCommentV3("TEST-1", "A **comment**", true)
+	assert.NoError(t, err)
+}
+
 func TestAddIssueWorklog(t *testing.T) {
 	var unexpectedStatusCode bool
 
@@ -640,6 +664,7 @@ func TestGetField(t *testing.T) {
 			Schema: struct {
 				DataType string `json:"type"`
 				Items    string `json:"items,omitempty"`
+				Custom   string `json:"custom,omitempty"`
 				FieldID  int    `json:"customId,omitempty"`
 			}{
 				DataType: "array",
@@ -653,9 +678,11 @@ func TestGetField(t *testing.T) {
 			Schema: struct {
 				DataType string `json:"type"`
 				Items    string `json:"items,omitempty"`
+				Custom   string `json:"custom,omitempty"`
 				FieldID  int    `json:"customId,omitempty"`
 			}{
 				DataType: "number",
+				Custom:   "com.atlassian.jpo:jpo-custom-field-original-story-points",
 				FieldID:  10111,
 			},
 		},
@@ -666,6 +693,7 @@ func TestGetField(t *testing.T) {
 			Schema: struct {
 				DataType string `json:"type"`
 				Items    string `json:"items,omitempty"`
+				Custom   string `json:"custom,omitempty"`
 				FieldID  int    `json:"customId,omitempty"`
 			}{
 				DataType: "number",
@@ -1,22 +1,22 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 )
 
 // Me struct holds response from /myself endpoint.
 type Me struct {
-	Login    string `json:"name"`
-	Name     string `json:"displayName"`
-	Email    string `json:"emailAddress"`
-	Timezone string `json:"timeZone"`
+	AccountID string `json:"accountId,omitempty"`
+	Login     string `json:"name"`
+	Name      string `json:"displayName"`
+	Email     string `json:"emailAddress"`
+	Timezone  string `json:"timeZone"`
 }
 
 // Me fetches response from /myself endpoint.
 func (c *Client) Me() (*Me, error) {
-	res, err := c.GetV2(context.Background(), "/myself", nil)
+	res, err := c.GetV2(c.Context(), "/myself", nil)
 	if err != nil {
 		return nil, err
 	}
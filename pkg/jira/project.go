@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 )
@@ -15,7 +14,7 @@ const (
 
 // Project fetches response from /project endpoint.
 func (c *Client) Project() ([]*Project, error) {
-	res, err := c.GetV2(context.Background(), "/project?expand=lead", nil)
+	res, err := c.GetV2(c.Context(), "/project?expand=lead", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,16 +1,16 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // Release fetches response from /project/{projectIdOrKey}/version endpoint.
 func (c *Client) Release(project string) ([]*ProjectVersion, error) {
 	path := fmt.Sprintf("/project/%s/versions", project)
-	res, err := c.Get(context.Background(), path, nil)
+	res, err := c.Get(c.Context(), path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -29,3 +29,125 @@ func (c *Client) Release(project string) ([]*ProjectVersion, error) {
 
 	return out, err
 }
+
+// ReleaseRequest holds request data for release create and update requests.
+// Empty fields are left unchanged in an update request.
+type ReleaseRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Project     string `json:"project,omitempty"`
+	StartDate   string `json:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	Released    *bool  `json:"released,omitempty"`
+	Archived    *bool  `json:"archived,omitempty"`
+}
+
+// GetRelease fetches a project version using GET /version/{id} endpoint.
+func (c *Client) GetRelease(id string) (*ProjectVersion, error) {
+	res, err := c.GetV2(c.Context(), "/version/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out ProjectVersion
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// CreateRelease creates a project version using POST /version endpoint.
+func (c *Client) CreateRelease(req *ReleaseRequest) (*ProjectVersion, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV2(c.Context(), "/version", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out ProjectVersion
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// UpdateRelease updates a project version using PUT /version/{id} endpoint.
+func (c *Client) UpdateRelease(id string, req *ReleaseRequest) (*ProjectVersion, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PutV2(c.Context(), "/version/"+id, body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out ProjectVersion
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// DeleteRelease deletes a project version using DELETE /version/{id} endpoint. The issues
+// with the version in their fix and affects versions are moved to the version with the
+// given id, ie: the versions are merged, if moveTo is not empty.
+func (c *Client) DeleteRelease(id, moveTo string) error {
+	path := "/version/" + id
+	if moveTo != "" {
+		qs := url.Values{}
+		qs.Set("moveFixIssuesTo", moveTo)
+		qs.Set("moveAffectedIssuesTo", moveTo)
+
+		path += "?" + qs.Encode()
+	}
+
+	res, err := c.DeleteV2(c.Context(), path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
@@ -1,9 +1,11 @@
 package jira
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -68,3 +70,128 @@ func TestReleases(t *testing.T) {
 	_, err = client.Release("1000")
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestGetRelease(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/version/1001", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "1001", "name": "Second", "description": "Release B", "projectId": 1000, "releaseDate": "2022-01-31"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetRelease("1001")
+	assert.NoError(t, err)
+
+	expected := &ProjectVersion{
+		ID:          "1001",
+		Name:        "Second",
+		Description: "Release B",
+		ProjectID:   1000,
+		ReleaseDate: "2022-01-31",
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestCreateRelease(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/version", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			expectedBody := `{"name":"v1.0.0","description":"First release","project":"TEST","releaseDate":"2022-01-31"}`
+			actualBody := new(strings.Builder)
+			_, _ = io.Copy(actualBody, r.Body)
+
+			assert.Equal(t, expectedBody, actualBody.String())
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"id": "1003", "name": "v1.0.0", "description": "First release", "projectId": 1000, "releaseDate": "2022-01-31"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	req := ReleaseRequest{
+		Name:        "v1.0.0",
+		Description: "First release",
+		Project:     "TEST",
+		ReleaseDate: "2022-01-31",
+	}
+	actual, err := client.CreateRelease(&req)
+	assert.NoError(t, err)
+	assert.Equal(t, "1003", actual.ID)
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateRelease(&req)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateRelease(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/version/1001", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		expectedBody := `{"releaseDate":"2022-01-31","released":true}`
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "1001", "name": "Second", "released": true, "releaseDate": "2022-01-31"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	released := true
+
+	actual, err := client.UpdateRelease("1001", &ReleaseRequest{ReleaseDate: "2022-01-31", Released: &released})
+	assert.NoError(t, err)
+	assert.True(t, actual.Released)
+}
+
+func TestDeleteRelease(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/version/1001", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+
+		qs := r.URL.Query()
+		assert.Equal(t, qs.Get("moveFixIssuesTo"), qs.Get("moveAffectedIssuesTo"))
+
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	assert.NoError(t, client.DeleteRelease("1001", ""))
+	assert.NoError(t, client.DeleteRelease("1001", "1002"))
+
+	unexpectedStatusCode = true
+
+	err := client.DeleteRelease("1001", "")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,168 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerRetryAfter         = "Retry-After"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// RetryPolicy configures automatic retries of failed requests.
+//
+// Rate limited requests (429) are retried for all methods as the server didn't process
+// them. Transient server errors and network failures are only retried for idempotent methods.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the initial request. Zero disables retries.
+	MaxRetries int
+	// MinBackoff is the base delay of the exponential backoff.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between retries. We give up if the server
+	// asks us to wait longer than this via Retry-After or X-RateLimit-Reset headers.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns a retry policy with sane defaults.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
+// WithRetry is a functional opt to retry failed requests using the given policy.
+func WithRetry(p RetryPolicy) ClientFunc {
+	return func(c *Client) {
+		c.retry = &p
+	}
+}
+
+// do sends the request and retries it based on the configured retry policy.
+func (c *Client) do(ctx context.Context, httpClient *http.Client, req *http.Request) (*http.Response, error) {
+	if c.retry == nil || c.retry.MaxRetries <= 0 {
+		return httpClient.Do(req.WithContext(ctx))
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		res, err := httpClient.Do(req.WithContext(ctx))
+
+		wait, retry := c.retry.shouldRetry(req, res, err, attempt)
+		if !retry {
+			return res, err
+		}
+		if res != nil {
+			// Drain the body so that the connection can be reused.
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry decides whether the request needs to be retried and returns the time to wait before the next attempt.
+func (p *RetryPolicy) shouldRetry(req *http.Request, res *http.Response, err error, attempt int) (time.Duration, bool) {
+	if attempt >= p.MaxRetries {
+		return 0, false
+	}
+	// Streamed bodies cannot be replayed.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, false
+	}
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+		return p.backoff(attempt), isIdempotent(req.Method)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if !isIdempotent(req.Method) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	if wait, ok := retryAfter(res.Header, time.Now()); ok {
+		if wait > p.MaxBackoff {
+			return 0, false
+		}
+		return wait, true
+	}
+	return p.backoff(attempt), true
+}
+
+// backoff calculates exponential backoff with jitter for the given attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff << attempt
+	if d <= 0 || d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 1 {
+		return d
+	}
+
+	// Equal jitter: half of the delay is fixed, the other half is random.
+	half := d / 2
+	return half + rand.N(half+1) //nolint:gosec
+}
+
+// retryAfter extracts the delay requested by the server from the rate limit headers.
+func retryAfter(h http.Header, now time.Time) (time.Duration, bool) {
+	if v := h.Get(headerRetryAfter); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			return max(time.Duration(secs)*time.Second, 0), true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return max(t.Sub(now), 0), true
+		}
+	}
+
+	if h.Get(headerRateLimitRemaining) == "0" {
+		if v := h.Get(headerRateLimitReset); v != "" {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				return max(t.Sub(now), 0), true
+			}
+			if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return max(time.Unix(epoch, 0).Sub(now), 0), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,230 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 2 * time.Second,
+	}
+}
+
+func TestRetryOnRateLimit(t *testing.T) {
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"key":"value"}`, string(body))
+
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithRetry(testRetryPolicy()))
+
+	// Rate limited requests are retried for non-idempotent methods as well.
+	res, err := client.PostV2(context.Background(), "/issue", []byte(`{"key":"value"}`), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	assert.Equal(t, 3, attempts)
+
+	_ = res.Body.Close()
+}
+
+func TestRetryOnServerError(t *testing.T) {
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithRetry(testRetryPolicy()))
+
+	// Idempotent requests are retried until max retries is reached.
+	res, err := client.GetV2(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	assert.Equal(t, 4, attempts)
+
+	_ = res.Body.Close()
+
+	// Non-idempotent requests are not retried on server errors.
+	attempts = 0
+
+	res, err = client.PostV2(context.Background(), "/issue", []byte(`{}`), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	assert.Equal(t, 1, attempts)
+
+	_ = res.Body.Close()
+}
+
+func TestRetryIsDisabledByDefault(t *testing.T) {
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	res, err := client.GetV2(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.Equal(t, 1, attempts)
+
+	_ = res.Body.Close()
+}
+
+func TestRetryGivesUpOnLongRetryAfter(t *testing.T) {
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithRetry(testRetryPolicy()))
+
+	res, err := client.GetV2(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.Equal(t, 1, attempts)
+
+	_ = res.Body.Close()
+}
+
+func TestRetryDoesNotReplayStreamedBody(t *testing.T) {
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithRetry(testRetryPolicy()))
+
+	pr, pw := io.Pipe()
+	go func() {
+		_, _ = io.Copy(pw, strings.NewReader("streamed"))
+		_ = pw.Close()
+	}()
+
+	res, err := client.requestWithReader(context.Background(), http.MethodPut, server.URL+"/upload", pr, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.Equal(t, 1, attempts)
+
+	_ = res.Body.Close()
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		headers  map[string]string
+		expected time.Duration
+		ok       bool
+	}{
+		{
+			name:     "it parses retry-after seconds",
+			headers:  map[string]string{"Retry-After": "5"},
+			expected: 5 * time.Second,
+			ok:       true,
+		},
+		{
+			name:     "it parses retry-after http date",
+			headers:  map[string]string{"Retry-After": "Mon, 01 Jan 2024 10:00:30 GMT"},
+			expected: 30 * time.Second,
+			ok:       true,
+		},
+		{
+			name: "it parses rate limit reset timestamp",
+			headers: map[string]string{
+				"X-RateLimit-Remaining": "0",
+				"X-RateLimit-Reset":     "2024-01-01T10:01:00Z",
+			},
+			expected: time.Minute,
+			ok:       true,
+		},
+		{
+			name: "it ignores rate limit reset if there are remaining requests",
+			headers: map[string]string{
+				"X-RateLimit-Remaining": "10",
+				"X-RateLimit-Reset":     "2024-01-01T10:01:00Z",
+			},
+			ok: false,
+		},
+		{
+			name:    "it returns false if there are no headers",
+			headers: map[string]string{},
+			ok:      false,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := http.Header{}
+			for k, v := range tc.headers {
+				h.Set(k, v)
+			}
+
+			wait, ok := retryAfter(h, now)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, wait)
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	p := RetryPolicy{MaxRetries: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, expected := range []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	} {
+		d := p.backoff(attempt)
+		assert.GreaterOrEqual(t, d, expected/2)
+		assert.LessOrEqual(t, d, expected)
+	}
+}
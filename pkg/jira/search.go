@@ -1,17 +1,21 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 )
 
+// defaultSearchPageSize is the number of issues fetched per request when iterating over search results.
+const defaultSearchPageSize = 100
+
 // SearchResult struct holds response from /search endpoint.
 type SearchResult struct {
 	IsLast        bool     `json:"isLast"`
 	NextPageToken string   `json:"nextPageToken"`
+	StartAt       int      `json:"startAt,omitempty"`
+	Total         int      `json:"total,omitempty"`
 	Issues        []*Issue `json:"issues"`
 }
 
@@ -21,12 +25,67 @@ func (c *Client) Search(jql string, limit uint) (*SearchResult, error) {
 	return c.search(path, apiVersion3)
 }
 
+// SearchPage fetches a single page of issues using v3 version of the Jira GET /search/jql endpoint.
+// Pass the NextPageToken of the previous result to fetch the next page.
+func (c *Client) SearchPage(jql, pageToken string, limit uint) (*SearchResult, error) {
+	path := fmt.Sprintf("/search/jql?jql=%s&maxResults=%d&fields=*all", url.QueryEscape(jql), limit)
+	if pageToken != "" {
+		path += "&nextPageToken=" + url.QueryEscape(pageToken)
+	}
+	return c.search(path, apiVersion3)
+}
+
 // SearchV2 searches an issues using v2 version of the Jira GET /search endpoint.
 func (c *Client) SearchV2(jql string, from, limit uint) (*SearchResult, error) {
 	path := fmt.Sprintf("/search?jql=%s&startAt=%d&maxResults=%d", url.QueryEscape(jql), from, limit)
 	return c.search(path, apiVersion2)
 }
 
+// SearchCount returns the approximate number of issues matching the JQL
+// using v3 version of the Jira POST /search/approximate-count endpoint.
+func (c *Client) SearchCount(jql string) (int, error) {
+	body, err := json.Marshal(struct {
+		JQL string `json:"jql"`
+	}{JQL: jql})
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := c.Post(c.Context(), "/search/approximate-count", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return 0, err
+	}
+	if res == nil {
+		return 0, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return 0, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Count int `json:"count"`
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out.Count, err
+}
+
+// SearchCountV2 returns the number of issues matching the JQL using
+// v2 version of the Jira GET /search endpoint without fetching the issues.
+func (c *Client) SearchCountV2(jql string) (int, error) {
+	res, err := c.SearchV2(jql, 0, 0)
+	if err != nil {
+		return 0, err
+	}
+	return res.Total, nil
+}
+
 func (c *Client) search(path, ver string) (*SearchResult, error) {
 	var (
 		res *http.Response
@@ -35,9 +94,9 @@ func (c *Client) search(path, ver string) (*SearchResult, error) {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(c.Context(), path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(c.Context(), path, nil)
 	}
 
 	if err != nil {
@@ -0,0 +1,157 @@
+package jira
+
+// SearchIterator iterates over search results page by page. It transparently
+// follows the `nextPageToken` for v3 and the `startAt` offset for v2 endpoints.
+//
+//	it := client.SearchIterator(jql, 0, 0)
+//	for it.Next() {
+//		for _, iss := range it.Issues() {
+//			...
+//		}
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type SearchIterator struct {
+	client   *Client
+	jql      string
+	ver      string
+	skip     uint
+	limit    uint
+	pageSize uint
+
+	startAt uint
+	token   string
+	fetched uint
+	issues  []*Issue
+	done    bool
+	err     error
+}
+
+// SearchIterator returns an iterator over the results of the v3 version of the Jira search endpoint.
+//
+// The first `from` issues are skipped and at most `limit` issues are returned. A zero limit means
+// that all matching issues are returned.
+func (c *Client) SearchIterator(jql string, from, limit uint) *SearchIterator {
+	return c.newSearchIterator(jql, from, limit, apiVersion3)
+}
+
+// SearchIteratorV2 is same as SearchIterator but uses the v2 version of the Jira search endpoint.
+func (c *Client) SearchIteratorV2(jql string, from, limit uint) *SearchIterator {
+	return c.newSearchIterator(jql, from, limit, apiVersion2)
+}
+
+func (c *Client) newSearchIterator(jql string, from, limit uint, ver string) *SearchIterator {
+	it := SearchIterator{
+		client:   c,
+		jql:      jql,
+		ver:      ver,
+		limit:    limit,
+		pageSize: defaultSearchPageSize,
+	}
+
+	// v2 supports offset natively, v3 requires us to skip the issues ourselves.
+	if ver == apiVersion2 {
+		it.startAt = from
+	} else {
+		it.skip = from
+	}
+
+	return &it
+}
+
+// Next fetches the next page of issues. It returns false when there are
+// no more issues to fetch or an error occurred.
+func (it *SearchIterator) Next() bool {
+	for !it.done {
+		if it.limit > 0 && it.fetched >= it.limit {
+			it.done = true
+			break
+		}
+
+		res, err := it.fetch()
+		if err != nil {
+			it.err = err
+			it.done = true
+			break
+		}
+
+		issues := res.Issues
+		it.done = it.isLastPage(res)
+
+		if it.skip > 0 {
+			n := min(it.skip, uint(len(issues)))
+			issues, it.skip = issues[n:], it.skip-n
+		}
+		if it.limit > 0 && it.fetched+uint(len(issues)) > it.limit {
+			issues = issues[:it.limit-it.fetched]
+		}
+		if len(issues) == 0 {
+			continue
+		}
+
+		it.fetched += uint(len(issues))
+		it.issues = issues
+
+		return true
+	}
+
+	it.issues = nil
+
+	return false
+}
+
+// Issues returns issues of the current page.
+func (it *SearchIterator) Issues() []*Issue {
+	return it.issues
+}
+
+// Err returns the error, if any, that occurred during iteration.
+func (it *SearchIterator) Err() error {
+	return it.err
+}
+
+// All iterates over all remaining pages and returns the collected issues.
+func (it *SearchIterator) All() ([]*Issue, error) {
+	var issues []*Issue
+
+	for it.Next() {
+		issues = append(issues, it.Issues()...)
+	}
+
+	return issues, it.Err()
+}
+
+func (it *SearchIterator) fetch() (*SearchResult, error) {
+	pageSize := it.pageSize
+	if it.limit > 0 && it.skip == 0 {
+		pageSize = min(pageSize, it.limit-it.fetched)
+	}
+
+	if it.ver == apiVersion2 {
+		res, err := it.client.SearchV2(it.jql, it.startAt, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		it.startAt += uint(len(res.Issues))
+		return res, nil
+	}
+
+	res, err := it.client.SearchPage(it.jql, it.token, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	it.token = res.NextPageToken
+
+	return res, nil
+}
+
+func (it *SearchIterator) isLastPage(res *SearchResult) bool {
+	if len(res.Issues) == 0 {
+		return true
+	}
+	if it.ver == apiVersion2 {
+		return res.Total > 0 && int(it.startAt) >= res.Total
+	}
+	return res.IsLast || res.NextPageToken == ""
+}
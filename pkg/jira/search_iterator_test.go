@@ -0,0 +1,135 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func issuesJSON(from, to int) string {
+	keys := make([]string, 0, to-from)
+	for i := from; i < to; i++ {
+		keys = append(keys, fmt.Sprintf(`{"key": "TEST-%d"}`, i+1))
+	}
+	return "[" + strings.Join(keys, ",") + "]"
+}
+
+func issueKeys(issues []*Issue) []string {
+	keys := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		keys = append(keys, iss.Key)
+	}
+	return keys
+}
+
+func newSearchPaginationServer(t *testing.T, total int, requests *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RawQuery)
+
+		qs := r.URL.Query()
+		limit, _ := strconv.Atoi(qs.Get("maxResults"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		switch r.URL.Path {
+		case "/rest/api/3/search/jql":
+			from := 0
+			if tok := qs.Get("nextPageToken"); tok != "" {
+				from, _ = strconv.Atoi(strings.TrimPrefix(tok, "page-"))
+			}
+			to := min(from+limit, total)
+
+			var next string
+			if to < total {
+				next = fmt.Sprintf("page-%d", to)
+			}
+			_, _ = fmt.Fprintf(w, `{"isLast": %t, "nextPageToken": %q, "issues": %s}`, next == "", next, issuesJSON(from, to))
+		case "/rest/api/2/search":
+			from, _ := strconv.Atoi(qs.Get("startAt"))
+			from = min(from, total)
+			to := min(from+limit, total)
+
+			_, _ = fmt.Fprintf(w, `{"startAt": %d, "total": %d, "issues": %s}`, from, total, issuesJSON(from, to))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestSearchIterator(t *testing.T) {
+	var requests []string
+
+	server := newSearchPaginationServer(t, 5, &requests)
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	it := client.SearchIterator("project=TEST", 0, 0)
+	it.pageSize = 2
+
+	var pages [][]string
+	for it.Next() {
+		pages = append(pages, issueKeys(it.Issues()))
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, [][]string{{"TEST-1", "TEST-2"}, {"TEST-3", "TEST-4"}, {"TEST-5"}}, pages)
+	assert.Len(t, requests, 3)
+	assert.Contains(t, requests[1], "nextPageToken=page-2")
+
+	// Skips the first issues and stops once the limit is reached.
+	it = client.SearchIterator("project=TEST", 1, 3)
+	it.pageSize = 2
+
+	issues, err := it.All()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TEST-2", "TEST-3", "TEST-4"}, issueKeys(issues))
+}
+
+func TestSearchIteratorV2(t *testing.T) {
+	var requests []string
+
+	server := newSearchPaginationServer(t, 5, &requests)
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	it := client.SearchIteratorV2("project=TEST", 0, 0)
+	it.pageSize = 2
+
+	issues, err := it.All()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4", "TEST-5"}, issueKeys(issues))
+	assert.Len(t, requests, 3)
+
+	// Offset is passed to the server as is.
+	requests = nil
+
+	it = client.SearchIteratorV2("project=TEST", 3, 10)
+	it.pageSize = 2
+
+	issues, err = it.All()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TEST-4", "TEST-5"}, issueKeys(issues))
+	assert.Contains(t, requests[0], "startAt=3")
+}
+
+func TestSearchIteratorError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	it := client.SearchIterator("project=TEST", 0, 0)
+
+	assert.False(t, it.Next())
+	assert.Error(t, &ErrUnexpectedResponse{}, it.Err())
+}
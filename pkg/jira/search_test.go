@@ -2,10 +2,12 @@
 package jira
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -140,3 +142,59 @@ func TestSearch(t *testing.T) {
 	_, err = client.SearchV2("project=TEST", 0, 100)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestSearchCount(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search/approximate-count", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.JSONEq(t, `{"jql":"project=TEST AND status=Done"}`, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"count":250}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchCount("project=TEST AND status=Done")
+	assert.NoError(t, err)
+	assert.Equal(t, 250, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.SearchCount("project=TEST AND status=Done")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestSearchCountV2(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/search", r.URL.Path)
+		assert.Equal(t, url.Values{
+			"jql":        []string{"project=TEST AND status=Done"},
+			"startAt":    []string{"0"},
+			"maxResults": []string{"0"},
+		}, r.URL.Query())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"startAt":0,"maxResults":0,"total":250,"issues":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchCountV2("project=TEST AND status=Done")
+	assert.NoError(t, err)
+	assert.Equal(t, 250, actual)
+}
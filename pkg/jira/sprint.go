@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -28,7 +27,7 @@ type SprintResult struct {
 // qp is an additional query parameters in key, value pair format, eg: state=closed.
 func (c *Client) Sprints(boardID int, qp string, from, limit int) (*SprintResult, error) {
 	res, err := c.GetV1(
-		context.Background(),
+		c.Context(),
 		fmt.Sprintf("/board/%d/sprint?%s&startAt=%d&maxResults=%d", boardID, qp, from, limit),
 		nil,
 	)
@@ -54,7 +53,7 @@ func (c *Client) Sprints(boardID int, qp string, from, limit int) (*SprintResult
 // GetSprint returns a single sprint given an ID.
 func (c *Client) GetSprint(sprintID int) (*Sprint, error) {
 	res, err := c.GetV1(
-		context.Background(),
+		c.Context(),
 		fmt.Sprintf("/sprint/%d", sprintID),
 		nil,
 	)
@@ -79,6 +78,91 @@ func (c *Client) GetSprint(sprintID int) (*Sprint, error) {
 	return &s, nil
 }
 
+// SprintRequest holds request data for sprint create and update requests.
+// Empty fields are left unchanged in an update request.
+type SprintRequest struct {
+	Name      string `json:"name,omitempty"`
+	BoardID   int    `json:"originBoardId,omitempty"`
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+	Goal      string `json:"goal,omitempty"`
+	State     string `json:"state,omitempty"`
+}
+
+// CreateSprint creates a future sprint in the given board using POST /sprint endpoint.
+func (c *Client) CreateSprint(req *SprintRequest) (*Sprint, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV1(c.Context(), "/sprint", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Sprint
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// UpdateSprint partially updates a sprint using POST /sprint/{sprintID} endpoint.
+// Only the fields set in the request are updated.
+func (c *Client) UpdateSprint(sprintID int, req *SprintRequest) (*Sprint, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV1(c.Context(), fmt.Sprintf("/sprint/%d", sprintID), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Sprint
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// StartSprint starts a future sprint. Jira requires both the start
+// and the end date to be set for a sprint to be started.
+func (c *Client) StartSprint(sprintID int, req *SprintRequest) (*Sprint, error) {
+	if req.StartDate == "" || req.EndDate == "" {
+		return nil, fmt.Errorf("start and end date are required to start a sprint")
+	}
+
+	r := *req
+	r.State = SprintStateActive
+
+	return c.UpdateSprint(sprintID, &r)
+}
+
 // EndSprint queries the existence of the sprint and
 // full updates the sprint with new status of closed.
 // Default behavior is all open tasks are sent to backlog.
@@ -100,7 +184,7 @@ func (c *Client) EndSprint(sprintID int) error {
 	}
 
 	res, err := c.PutV1(
-		context.Background(),
+		c.Context(),
 		fmt.Sprintf("/sprint/%d", sprintID),
 		body,
 		Header{
@@ -171,7 +255,7 @@ func (c *Client) SprintIssues(sprintID int, jql string, from, limit uint) (*Sear
 		path += fmt.Sprintf("&jql=%s", url.QueryEscape(jql))
 	}
 
-	res, err := c.GetV1(context.Background(), path, nil)
+	res, err := c.GetV1(c.Context(), path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +288,37 @@ func (c *Client) SprintIssuesAdd(id string, issues ...string) error {
 		return err
 	}
 
-	res, err := c.PostV1(context.Background(), path, body, Header{
+	res, err := c.PostV1(c.Context(), path, body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// MoveIssuesToBacklog moves issues to the backlog, ie: removes them from all
+// sprints, using POST /backlog/issue endpoint. At most 50 issues can be moved at once.
+func (c *Client) MoveIssuesToBacklog(issues ...string) error {
+	data := struct {
+		Issues []string `json:"issues"`
+	}{Issues: issues}
+
+	body, err := json.Marshal(&data)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PostV1(c.Context(), "/backlog/issue", body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
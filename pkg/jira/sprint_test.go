@@ -414,3 +414,146 @@ func TestEndSprint(t *testing.T) {
 	err = client.EndSprint(5)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestCreateSprint(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Accept"))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			expectedBody := `{"name":"sprint 1","originBoardId":3,"goal":"sprint 1 goal"}`
+			actualBody := new(strings.Builder)
+			_, _ = io.Copy(actualBody, r.Body)
+
+			assert.Equal(t, expectedBody, actualBody.String())
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"id": 5, "name": "sprint 1", "state": "future", "originBoardId": 3, "goal": "sprint 1 goal"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	sprint, err := client.CreateSprint(&SprintRequest{Name: "sprint 1", BoardID: 3, Goal: "sprint 1 goal"})
+	assert.NoError(t, err)
+	assert.Equal(t, &Sprint{ID: 5, Name: "sprint 1", Status: SprintStateFuture, BoardID: 3, Goal: "sprint 1 goal"}, sprint)
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateSprint(&SprintRequest{Name: "sprint 1", BoardID: 3})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateSprint(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint/5", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, "POST", r.Method)
+
+			expectedBody := `{"name":"sprint 1 renamed"}`
+			actualBody := new(strings.Builder)
+			_, _ = io.Copy(actualBody, r.Body)
+
+			assert.Equal(t, expectedBody, actualBody.String())
+
+			resp, err := os.ReadFile("./testdata/sprint-get.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	sprint, err := client.UpdateSprint(5, &SprintRequest{Name: "sprint 1 renamed"})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, sprint.ID)
+
+	unexpectedStatusCode = true
+
+	_, err = client.UpdateSprint(5, &SprintRequest{Name: "sprint 1 renamed"})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestStartSprint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint/5", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		expectedBody := `{"startDate":"2025-04-11T15:22:00.000+1000","endDate":"2025-04-20T01:22:00.000+1000","state":"active"}`
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		resp, err := os.ReadFile("./testdata/sprint-get.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	sprint, err := client.StartSprint(5, &SprintRequest{
+		StartDate: "2025-04-11T15:22:00.000+1000",
+		EndDate:   "2025-04-20T01:22:00.000+1000",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, SprintStateActive, sprint.Status)
+
+	_, err = client.StartSprint(5, &SprintRequest{StartDate: "2025-04-11T15:22:00.000+1000"})
+	assert.EqualError(t, err, "start and end date are required to start a sprint")
+}
+
+func TestMoveIssuesToBacklog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/backlog/issue", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, "POST", r.Method)
+
+			expectedBody := `{"issues":["TEST-1","TEST-2"]}`
+			actualBody := new(strings.Builder)
+			_, _ = io.Copy(actualBody, r.Body)
+
+			assert.Equal(t, expectedBody, actualBody.String())
+
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.MoveIssuesToBacklog("TEST-1", "TEST-2")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.MoveIssuesToBacklog("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
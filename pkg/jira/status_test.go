@@ -0,0 +1,48 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatuses(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/status", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/statuses.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.Statuses()
+	assert.NoError(t, err)
+	assert.Len(t, actual, 3)
+
+	expected := &Status{ID: "3", Name: "In Progress"}
+	expected.StatusCategory.Key = "indeterminate"
+	expected.StatusCategory.Name = "In Progress"
+
+	assert.Equal(t, expected, actual[1])
+
+	unexpectedStatusCode = true
+
+	_, err = client.Statuses()
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
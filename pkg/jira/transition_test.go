@@ -172,6 +172,7 @@ func TestBuildCustomFieldsForTransition(t *testing.T) {
 			Schema: struct {
 				DataType string `json:"type"`
 				Items    string `json:"items,omitempty"`
+				Custom   string `json:"custom,omitempty"`
 			}{DataType: "number"},
 		},
 		{
@@ -180,6 +181,7 @@ func TestBuildCustomFieldsForTransition(t *testing.T) {
 			Schema: struct {
 				DataType string `json:"type"`
 				Items    string `json:"items,omitempty"`
+				Custom   string `json:"custom,omitempty"`
 			}{DataType: "option"},
 		},
 		{
@@ -188,6 +190,7 @@ func TestBuildCustomFieldsForTransition(t *testing.T) {
 			Schema: struct {
 				DataType string `json:"type"`
 				Items    string `json:"items,omitempty"`
+				Custom   string `json:"custom,omitempty"`
 			}{DataType: "array"},
 		},
 	}
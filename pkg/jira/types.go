@@ -44,6 +44,9 @@ type ProjectVersion struct {
 	Name        string      `json:"name"`
 	ProjectID   int         `json:"projectId"`
 	Released    bool        `json:"released"`
+	StartDate   string      `json:"startDate,omitempty"`
+	ReleaseDate string      `json:"releaseDate,omitempty"`
+	Overdue     bool        `json:"overdue,omitempty"`
 }
 
 // Board holds board info.
@@ -122,8 +125,24 @@ type IssueFields struct {
 		InwardIssue  *Issue `json:"inwardIssue,omitempty"`
 		OutwardIssue *Issue `json:"outwardIssue,omitempty"`
 	} `json:"issueLinks"`
-	Created string `json:"created"`
-	Updated string `json:"updated"`
+	Attachments []*Attachment `json:"attachment,omitempty"`
+	Created     string        `json:"created"`
+	Updated     string        `json:"updated"`
+
+	// CustomFields holds raw values of the custom fields, ie: customfield_*, keyed by the field ID.
+	CustomFields map[string]json.RawMessage `json:"-"`
+}
+
+// Attachment holds issue attachment info.
+type Attachment struct {
+	ID        string `json:"id"`
+	Filename  string `json:"filename"`
+	Author    User   `json:"author"`
+	Created   string `json:"created"`
+	Size      int64  `json:"size"`
+	MimeType  string `json:"mimeType"`
+	Content   string `json:"content"`
+	Thumbnail string `json:"thumbnail,omitempty"`
 }
 
 // Field holds field info.
@@ -134,6 +153,7 @@ type Field struct {
 	Schema struct {
 		DataType string `json:"type"`
 		Items    string `json:"items,omitempty"`
+		Custom   string `json:"custom,omitempty"`
 		FieldID  int    `json:"customId,omitempty"`
 	} `json:"schema"`
 }
@@ -145,8 +165,21 @@ type IssueTypeField struct {
 	Schema struct {
 		DataType string `json:"type"`
 		Items    string `json:"items,omitempty"`
+		Custom   string `json:"custom,omitempty"`
 	} `json:"schema"`
 	FieldID string `json:"fieldId,omitempty"`
+	// Required, HasDefaultValue and AllowedValues are
+	// only set in the create metadata of an issue type.
+	Required        bool                 `json:"required,omitempty"`
+	HasDefaultValue bool                 `json:"hasDefaultValue,omitempty"`
+	AllowedValues   []*FieldAllowedValue `json:"allowedValues,omitempty"`
+}
+
+// FieldAllowedValue holds a value allowed for an issue field, eg: a priority or a component.
+type FieldAllowedValue struct {
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
 }
 
 // IssueType holds issue type info.
@@ -174,6 +207,7 @@ type Sprint struct {
 	EndDate      string `json:"endDate"`
 	CompleteDate string `json:"completeDate,omitempty"`
 	BoardID      int    `json:"originBoardId,omitempty"`
+	Goal         string `json:"goal,omitempty"`
 }
 
 // Transition holds issue transition info.
@@ -181,6 +215,17 @@ type Transition struct {
 	ID          json.Number `json:"id"`
 	Name        string      `json:"name"`
 	IsAvailable bool        `json:"isAvailable"`
+	To          Status      `json:"to"`
+}
+
+// Status holds issue status info.
+type Status struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	StatusCategory struct {
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	} `json:"statusCategory"`
 }
 
 // User holds user info.
@@ -191,3 +236,71 @@ type User struct {
 	DisplayName string `json:"displayName"`
 	Active      bool   `json:"active"`
 }
+
+// Comment holds comment info.
+type Comment struct {
+	ID           string `json:"id"`
+	Author       User   `json:"author"`
+	UpdateAuthor User   `json:"updateAuthor"`
+	Body         string `json:"body"`
+	Created      string `json:"created"`
+	Updated      string `json:"updated"`
+}
+
+// CommentResult holds response from /issue/{key}/comment endpoint.
+type CommentResult struct {
+	StartAt    int        `json:"startAt"`
+	MaxResults int        `json:"maxResults"`
+	Total      int        `json:"total"`
+	Comments   []*Comment `json:"comments"`
+}
+
+// Worklog holds worklog info.
+type Worklog struct {
+	ID               string `json:"id"`
+	IssueID          string `json:"issueId"`
+	Author           User   `json:"author"`
+	UpdateAuthor     User   `json:"updateAuthor"`
+	Comment          string `json:"comment"`
+	Started          string `json:"started"`
+	Created          string `json:"created"`
+	Updated          string `json:"updated"`
+	TimeSpent        string `json:"timeSpent"`
+	TimeSpentSeconds int    `json:"timeSpentSeconds"`
+}
+
+// WorklogResult holds response from /issue/{key}/worklog endpoint.
+type WorklogResult struct {
+	StartAt    int        `json:"startAt"`
+	MaxResults int        `json:"maxResults"`
+	Total      int        `json:"total"`
+	Worklogs   []*Worklog `json:"worklogs"`
+}
+
+// ChangelogItem holds a single field change in an issue history.
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype"`
+	FieldID    string `json:"fieldId,omitempty"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+// ChangelogHistory holds a set of field changes made at once.
+type ChangelogHistory struct {
+	ID      string           `json:"id"`
+	Author  User             `json:"author"`
+	Created string           `json:"created"`
+	Items   []*ChangelogItem `json:"items"`
+}
+
+// ChangelogResult holds response from /issue/{key}/changelog endpoint.
+type ChangelogResult struct {
+	StartAt    int                 `json:"startAt"`
+	MaxResults int                 `json:"maxResults"`
+	Total      int                 `json:"total"`
+	IsLast     bool                `json:"isLast"`
+	Histories  []*ChangelogHistory `json:"values"`
+}
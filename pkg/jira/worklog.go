@@ -0,0 +1,146 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+)
+
+// GetIssueWorklogs fetches worklogs of an issue using GET /issue/{key}/worklog endpoint.
+func (c *Client) GetIssueWorklogs(key string, from, limit uint) (*WorklogResult, error) {
+	path := fmt.Sprintf("/issue/%s/worklog?startAt=%d&maxResults=%d", key, from, limit)
+
+	res, err := c.GetV2(c.Context(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out WorklogResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetAllIssueWorklogs fetches all worklogs of an issue by following the pagination.
+func (c *Client) GetAllIssueWorklogs(key string) ([]*Worklog, error) {
+	const limit = 100
+
+	var (
+		from     uint
+		worklogs []*Worklog
+	)
+
+	for {
+		res, err := c.GetIssueWorklogs(key, from, limit)
+		if err != nil {
+			return nil, err
+		}
+		worklogs = append(worklogs, res.Worklogs...)
+
+		from += uint(len(res.Worklogs))
+		if len(res.Worklogs) == 0 || int(from) >= res.Total {
+			break
+		}
+	}
+
+	return worklogs, nil
+}
+
+// GetIssueWorklog fetches a single worklog using GET /issue/{key}/worklog/{id} endpoint.
+func (c *Client) GetIssueWorklog(key, id string) (*Worklog, error) {
+	res, err := c.GetV2(c.Context(), fmt.Sprintf("/issue/%s/worklog/%s", key, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Worklog
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+type issueWorklogUpdateRequest struct {
+	Started   string `json:"started,omitempty"`
+	TimeSpent string `json:"timeSpent,omitempty"`
+	Comment   string `json:"comment,omitempty"`
+}
+
+// UpdateIssueWorklog updates a worklog using PUT /issue/{key}/worklog/{id} endpoint.
+// Empty values are left unchanged.
+func (c *Client) UpdateIssueWorklog(key, id, started, timeSpent, comment, newEstimate string) error {
+	worklogReq := issueWorklogUpdateRequest{
+		Started:   started,
+		TimeSpent: timeSpent,
+	}
+	if comment != "" {
+		worklogReq.Comment = md.ToJiraMD(comment)
+	}
+	body, err := json.Marshal(&worklogReq)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/issue/%s/worklog/%s", key, id)
+	if newEstimate != "" {
+		path = fmt.Sprintf("%s?adjustEstimate=new&newEstimate=%s", path, url.QueryEscape(newEstimate))
+	}
+	res, err := c.PutV2(c.Context(), path, body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// DeleteIssueWorklog removes a worklog using DELETE /issue/{key}/worklog/{id} endpoint.
+func (c *Client) DeleteIssueWorklog(key, id, newEstimate string) error {
+	path := fmt.Sprintf("/issue/%s/worklog/%s", key, id)
+	if newEstimate != "" {
+		path = fmt.Sprintf("%s?adjustEstimate=new&newEstimate=%s", path, url.QueryEscape(newEstimate))
+	}
+
+	res, err := c.DeleteV2(c.Context(), path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package jira
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIssueWorklogs(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog", r.URL.Path)
+
+		qs := r.URL.Query()
+		assert.Equal(t, "0", qs.Get("startAt"))
+		assert.Equal(t, "2", qs.Get("maxResults"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/worklogs.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueWorklogs("TEST-1", 0, 2)
+	assert.NoError(t, err)
+
+	personA := User{AccountID: "a-b-c", DisplayName: "Person A", Active: true}
+	personB := User{AccountID: "d-e-f", DisplayName: "Person B", Active: true}
+
+	expected := &WorklogResult{
+		StartAt:    0,
+		MaxResults: 2,
+		Total:      3,
+		Worklogs: []*Worklog{
+			{
+				ID:               "20000",
+				IssueID:          "10010",
+				Author:           personA,
+				UpdateAuthor:     personA,
+				Comment:          "Investigation",
+				Started:          "2022-01-03T09:30:00.000+0100",
+				Created:          "2022-01-03T12:00:00.000+0100",
+				Updated:          "2022-01-03T12:00:00.000+0100",
+				TimeSpent:        "2h 30m",
+				TimeSpentSeconds: 9000,
+			},
+			{
+				ID:               "20001",
+				IssueID:          "10010",
+				Author:           personB,
+				UpdateAuthor:     personB,
+				Started:          "2022-01-04T10:00:00.000+0100",
+				Created:          "2022-01-04T11:00:00.000+0100",
+				Updated:          "2022-01-04T11:00:00.000+0100",
+				TimeSpent:        "1h",
+				TimeSpentSeconds: 3600,
+			},
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueWorklogs("TEST-1", 0, 2)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetAllIssueWorklogs(t *testing.T) {
+	var calls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog", r.URL.Path)
+		calls++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			_, _ = w.Write([]byte(`{"startAt": 0, "total": 3, "worklogs": [{"id": "1"}, {"id": "2"}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"startAt": 2, "total": 3, "worklogs": [{"id": "3"}]}`))
+		default:
+			t.Fatalf("unexpected startAt: %s", r.URL.Query().Get("startAt"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetAllIssueWorklogs("TEST-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []*Worklog{{ID: "1"}, {ID: "2"}, {ID: "3"}}, actual)
+	assert.Equal(t, 2, calls)
+}
+
+func TestUpdateIssueWorklog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog/20000", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var req map[string]string
+		assert.NoError(t, json.Unmarshal(body, &req))
+		assert.Equal(t, map[string]string{"timeSpent": "3h"}, req)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id": "20000"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.UpdateIssueWorklog("TEST-1", "20000", "", "3h", "", "")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.UpdateIssueWorklog("TEST-1", "20000", "", "3h", "", "")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteIssueWorklog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog/20000", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "adjustEstimate=new&newEstimate=1h", r.URL.RawQuery)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteIssueWorklog("TEST-1", "20000", "1h")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteIssueWorklog("TEST-1", "20000", "1h")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -225,6 +225,66 @@ func (j *JQL) compile() string {
 	return q
 }
 
+// WithProject scopes the raw JQL query to the project. The query is grouped so that its OR
+// conditions apply within the project only, and its ORDER BY clause is kept outside the group.
+// The project isn't added if the query already filters by a project.
+func WithProject(project, q string) string {
+	q = strings.TrimSpace(q)
+	if project == "" {
+		return q
+	}
+
+	filter, orderBy := splitOrderBy(q)
+
+	var out string
+	switch {
+	case filter == "":
+		out = fmt.Sprintf("project=%q", project)
+	case hasProjectFilter(filter):
+		out = filter
+	default:
+		out = fmt.Sprintf("project=%q AND (%s)", project, filter)
+	}
+	if orderBy != "" {
+		out += " " + orderBy
+	}
+	return out
+}
+
+// splitOrderBy splits the query into its filter and its ORDER BY clause.
+// An ORDER BY within a quoted string is not treated as the clause.
+func splitOrderBy(q string) (string, string) {
+	regx := regexp.MustCompile(`(?i)\border\s+by\b`)
+
+	for _, loc := range regx.FindAllStringIndex(q, -1) {
+		if inQuotes(q[:loc[0]]) {
+			continue
+		}
+		return strings.TrimSpace(q[:loc[0]]), strings.TrimSpace(q[loc[0]:])
+	}
+	return q, ""
+}
+
+// inQuotes checks if the end of the string is within a quoted string.
+func inQuotes(s string) bool {
+	var quote rune
+
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case quote == 0 && (r == '"' || r == '\''):
+			quote = r
+		case r == quote:
+			quote = 0
+		}
+	}
+	return quote != 0
+}
+
 func hasProjectFilter(str string) bool {
 	regx := "(?i)((project)[\\s]*?={0,1}\\b)[^'.']"
 	m, _ := regexp.MatchString(regx, str)
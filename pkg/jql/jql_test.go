@@ -313,6 +313,57 @@ func TestJQL(t *testing.T) {
 	}
 }
 
+func TestWithProject(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "it groups the query",
+			input:    "labels=a OR labels=b",
+			expected: `project="TEST" AND (labels=a OR labels=b)`,
+		},
+		{
+			name:     "it keeps the order by clause outside the group",
+			input:    "labels=a OR labels=b order by created DESC",
+			expected: `project="TEST" AND (labels=a OR labels=b) order by created DESC`,
+		},
+		{
+			name:     "it skips the project if the query filters by a project",
+			input:    " project IN (TEST1, TEST2) OR labels=a ORDER BY key",
+			expected: "project IN (TEST1, TEST2) OR labels=a ORDER BY key",
+		},
+		{
+			name:     "it ignores order by within quotes",
+			input:    `summary ~ "sort order by date" OR labels=a`,
+			expected: `project="TEST" AND (summary ~ "sort order by date" OR labels=a)`,
+		},
+		{
+			name:     "it queries the project with only an order by clause",
+			input:    "ORDER BY rank ASC",
+			expected: `project="TEST" ORDER BY rank ASC`,
+		},
+		{
+			name:     "it queries the project without a query",
+			input:    "",
+			expected: `project="TEST"`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, WithProject("TEST", tc.input))
+		})
+	}
+
+	assert.Equal(t, "labels=a OR labels=b", WithProject("", "labels=a OR labels=b"))
+}
+
 func TestHasProject(t *testing.T) {
 	cases := []struct {
 		input    string
@@ -0,0 +1,285 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+const (
+	bulkButtonYes = "Yes"
+	bulkButtonNo  = "No"
+	bulkButtonOK  = "OK"
+)
+
+// BulkAction is an action that can be applied to all marked rows in the table at once.
+type BulkAction struct {
+	// Name is the name of the action, eg: Transition.
+	Name string
+	// Options returns the values to choose from before applying the action to the rows,
+	// eg: the states to transition to.
+	Options func(rows []int) ([]string, error)
+	// Input is the label of the text input the value is asked from if there are no options.
+	Input string
+	// Confirm asks for a confirmation before applying the action.
+	Confirm bool
+	// Destructive marks the rows as removed once the action is applied successfully, eg: delete.
+	Destructive bool
+	// Handler applies the action with the chosen value to a row. It runs outside the UI goroutine
+	// so it must not change the table data, the returned func, if any, is run on the UI goroutine
+	// to update the table data once the action is applied to the row.
+	Handler func(row int, value string) (func(), error)
+}
+
+// BulkResult is the result of a bulk action.
+type BulkResult struct {
+	Total    int
+	Failures []error
+}
+
+// String returns the summary of the bulk action.
+func (br BulkResult) String() string {
+	var s strings.Builder
+
+	s.WriteString(fmt.Sprintf("Applied to %d of %d row(s).", br.Total-len(br.Failures), br.Total))
+	if len(br.Failures) > 0 {
+		s.WriteString(fmt.Sprintf("\n\n%d failure(s):", len(br.Failures)))
+		for _, err := range br.Failures {
+			s.WriteString("\n" + err.Error())
+		}
+	}
+
+	return s.String()
+}
+
+// WithBulkActions sets actions that can be applied to the marked rows when a user press 'b'.
+func WithBulkActions(actions ...BulkAction) TableOption {
+	return func(t *Table) {
+		t.bulkActions = actions
+	}
+}
+
+// toggleMark marks or unmarks a row.
+func (t *Table) toggleMark(r int) {
+	if r < 1 || r >= len(t.data) {
+		return
+	}
+	if _, ok := t.removed[r]; ok {
+		return
+	}
+	if _, ok := t.marked[r]; ok {
+		delete(t.marked, r)
+	} else {
+		t.marked[r] = struct{}{}
+	}
+	t.paintRow(r)
+	t.refreshFooter()
+}
+
+// mark marks a row.
+func (t *Table) mark(r int) {
+	if _, ok := t.marked[r]; !ok {
+		t.toggleMark(r)
+	}
+}
+
+// markedRows returns the marked rows in order. The selected row is
+// returned if no rows are marked.
+func (t *Table) markedRows() []int {
+	rows := make([]int, 0, len(t.marked))
+	for r := range t.marked {
+		rows = append(rows, r)
+	}
+	sort.Ints(rows)
+
+	if len(rows) == 0 {
+		r, _ := t.view.GetSelection()
+		if _, ok := t.removed[r]; !ok && r > 0 {
+			rows = append(rows, r)
+		}
+	}
+
+	return rows
+}
+
+// paintRow highlights the marked rows and dims the removed ones.
+func (t *Table) paintRow(r int) {
+	style := tcell.StyleDefault
+	if _, ok := t.marked[r]; ok {
+		style = style.Background(tcell.ColorDarkSlateGray).Foreground(tcell.ColorWhite)
+	}
+	if _, ok := t.removed[r]; ok {
+		style = style.Foreground(tcell.ColorGray).StrikeThrough(true)
+	}
+
+	for c := 0; c < t.view.GetColumnCount(); c++ {
+		if cell := t.view.GetCell(r, c); cell != nil {
+			cell.SetStyle(style)
+		}
+	}
+}
+
+func (t *Table) refreshFooter() {
+	text := t.footerText
+	if n := len(t.marked); n > 0 {
+		text = fmt.Sprintf("%s (%d selected, press 'b' for bulk actions)", text, n)
+	}
+	t.footer.SetText(pad(text, 1))
+}
+
+// bulk asks for the action to apply to the marked rows.
+func (t *Table) bulk() {
+	rows := t.markedRows()
+	if len(t.bulkActions) == 0 || len(rows) == 0 || t.bulkRunning {
+		return
+	}
+
+	names := make([]string, 0, len(t.bulkActions))
+	for _, a := range t.bulkActions {
+		names = append(names, a.Name)
+	}
+
+	t.showAction(
+		fmt.Sprintf("Select an action to apply to %d row(s):", len(rows)), names, "",
+		func(idx int, _ string) {
+			if idx < 0 {
+				return
+			}
+			t.bulkValue(t.bulkActions[idx], rows)
+		},
+	)
+}
+
+// bulkValue asks for the value of the action, eg: the state to transition to.
+func (t *Table) bulkValue(action BulkAction, rows []int) {
+	switch {
+	case action.Options != nil:
+		t.painter.HidePage("action")
+		t.painter.ShowPage("secondary").SendToFront("secondary")
+
+		go func() {
+			options, err := action.Options(rows)
+
+			t.screen.QueueUpdateDraw(func() {
+				t.painter.HidePage("secondary")
+
+				if err != nil {
+					t.showResult(fmt.Sprintf("Error: %s", err))
+					return
+				}
+				if len(options) == 0 {
+					t.showResult(fmt.Sprintf("No options available to %s the selected row(s).", strings.ToLower(action.Name)))
+					return
+				}
+				t.showAction(
+					fmt.Sprintf("Select the value to %s %d row(s) with:", strings.ToLower(action.Name), len(rows)), options, "",
+					func(idx int, value string) {
+						if idx >= 0 {
+							t.bulkConfirm(action, rows, value)
+						}
+					},
+				)
+			})
+		}()
+	case action.Input != "":
+		t.showAction(
+			fmt.Sprintf("%s %d row(s):", action.Name, len(rows)), []string{bulkButtonOK}, action.Input,
+			func(idx int, _ string) {
+				if idx < 0 {
+					return
+				}
+				value := strings.TrimSpace(t.action.GetInputText())
+				if value == "" {
+					t.action.GetFooter().SetText(fmt.Sprintf("Error: %s is required", action.Input)).SetTextColor(tcell.ColorRed)
+					return
+				}
+				t.bulkConfirm(action, rows, value)
+			},
+		)
+	default:
+		t.bulkConfirm(action, rows, "")
+	}
+}
+
+// bulkConfirm asks for a confirmation if required before applying the action.
+func (t *Table) bulkConfirm(action BulkAction, rows []int, value string) {
+	if !action.Confirm {
+		t.bulkApply(action, rows, value)
+		return
+	}
+
+	t.showAction(
+		fmt.Sprintf("Are you sure you want to %s %d row(s)?", strings.ToLower(action.Name), len(rows)),
+		[]string{bulkButtonYes, bulkButtonNo}, "",
+		func(_ int, label string) {
+			if label != bulkButtonYes {
+				t.painter.HidePage("action")
+				return
+			}
+			t.bulkApply(action, rows, value)
+		},
+	)
+}
+
+// bulkApply applies the action to the rows one by one and shows the progress. Rows can't be
+// sorted while the action is being applied so that the rows passed to the handler stay valid.
+func (t *Table) bulkApply(action BulkAction, rows []int, value string) {
+	t.showAction(fmt.Sprintf("%s: 0 of %d row(s) processed", action.Name, len(rows)), nil, "", nil)
+	t.action.GetFooter().SetText("Processing. Please wait...").SetTextColor(tcell.ColorGray)
+	t.bulkRunning = true
+
+	go func() {
+		res := BulkResult{Total: len(rows)}
+		done := make(map[int]struct{}, len(rows))
+
+		for i, r := range rows {
+			update, err := action.Handler(r, value)
+			if err != nil {
+				res.Failures = append(res.Failures, err)
+			} else {
+				done[r] = struct{}{}
+			}
+
+			n := i + 1
+			t.screen.QueueUpdateDraw(func() {
+				if update != nil {
+					update()
+				}
+				t.action.SetText(fmt.Sprintf("%s: %d of %d row(s) processed", action.Name, n, len(rows)))
+			})
+		}
+
+		t.screen.QueueUpdateDraw(func() {
+			t.bulkRunning = false
+
+			// Rows that failed are kept marked so that the action can be retried.
+			for r := range done {
+				delete(t.marked, r)
+				if action.Destructive {
+					t.removed[r] = struct{}{}
+				}
+			}
+			renderTableCell(t, t.data)
+			t.refreshFooter()
+			t.showResult(res.String())
+		})
+	}()
+}
+
+func (t *Table) showResult(text string) {
+	t.showAction(text, []string{bulkButtonOK}, "", func(int, string) {
+		t.painter.HidePage("action")
+	})
+}
+
+func (t *Table) showAction(text string, buttons []string, input string, done func(int, string)) {
+	t.action.SetInput(input).ClearButtons().AddButtons(buttons).SetFocus(0)
+	t.action.SetText(text).SetDoneFunc(done)
+	t.action.GetFooter().SetText("").SetTextColor(tcell.ColorGray)
+	if len(buttons) > 0 {
+		t.action.GetFooter().SetText("Use TAB or ← → to navigate, ENTER to select, ESC to cancel.")
+	}
+	t.painter.ShowPage("action").SendToFront("action")
+}
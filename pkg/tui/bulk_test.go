@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkResultString(t *testing.T) {
+	res := BulkResult{Total: 3}
+	assert.Equal(t, "Applied to 3 of 3 row(s).", res.String())
+
+	res.Failures = []error{
+		errors.New("TEST-1: transition 'Done' not available"),
+		errors.New("TEST-3: unexpected status code"),
+	}
+	assert.Equal(t, `Applied to 1 of 3 row(s).
+
+2 failure(s):
+TEST-1: transition 'Done' not available
+TEST-3: unexpected status code`, res.String())
+}
+
+func TestTableMarkedRows(t *testing.T) {
+	tbl := NewTable(WithTableFooterText("Showing 3 results"), WithBulkActions(BulkAction{Name: "Delete"}))
+	tbl.data = TableData{
+		{"KEY", "SUMMARY"},
+		{"TEST-1", "Summary 1"},
+		{"TEST-2", "Summary 2"},
+		{"TEST-3", "Summary 3"},
+	}
+	tbl.render(tbl.data)
+
+	// The selected row is used if no rows are marked.
+	tbl.view.Select(2, 0)
+	assert.Equal(t, []int{2}, tbl.markedRows())
+
+	tbl.toggleMark(3)
+	tbl.toggleMark(1)
+	tbl.toggleMark(0) // Header can't be marked.
+	tbl.toggleMark(4) // Out of range.
+	assert.Equal(t, []int{1, 3}, tbl.markedRows())
+	assert.Equal(t, " Showing 3 results (2 selected, press 'b' for bulk actions) ", tbl.footer.GetText(false))
+
+	tbl.mark(3)
+	assert.Equal(t, []int{1, 3}, tbl.markedRows())
+
+	tbl.toggleMark(3)
+	assert.Equal(t, []int{1}, tbl.markedRows())
+
+	// Removed rows can't be marked.
+	tbl.removed[2] = struct{}{}
+	tbl.toggleMark(2)
+	assert.Equal(t, []int{1}, tbl.markedRows())
+
+	tbl.toggleMark(1)
+	assert.Empty(t, tbl.markedRows())
+	assert.Equal(t, " Showing 3 results ", tbl.footer.GetText(false))
+}
@@ -0,0 +1,432 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/tui/primitive"
+)
+
+// KanbanCard is a card in a kanban column.
+type KanbanCard struct {
+	Key     string
+	Summary string
+}
+
+// KanbanColumn is a column in a kanban board.
+type KanbanColumn struct {
+	Name  string
+	Max   int // Max is the WIP limit of the column, 0 means no limit.
+	Cards []KanbanCard
+}
+
+// KanbanData is the data to be displayed in a kanban board.
+type KanbanData []*KanbanColumn
+
+// Get returns the card at the given column and row.
+func (kd KanbanData) Get(col, row int) (KanbanCard, bool) {
+	if col < 0 || col >= len(kd) || row < 0 || row >= len(kd[col].Cards) {
+		return KanbanCard{}, false
+	}
+	return kd[col].Cards[row], true
+}
+
+// Move moves the card at the given column and row to the column with the given name.
+//
+// It returns the index of the column the card is moved to. The card is removed from
+// the board and -1 is returned if there is no such column, ie: the new status of the
+// issue is not mapped to any column in the board.
+func (kd KanbanData) Move(col, row int, to string) int {
+	card, ok := kd.Get(col, row)
+	if !ok {
+		return -1
+	}
+	kd[col].Cards = append(kd[col].Cards[:row], kd[col].Cards[row+1:]...)
+
+	for i, c := range kd {
+		if c.Name == to {
+			c.Cards = append(c.Cards, card)
+			return i
+		}
+	}
+	return -1
+}
+
+// KanbanMoveHandlerFunc is a handler for kanban move action.
+// It returns the name of the column the card should be moved to.
+type KanbanMoveHandlerFunc func(action string) (string, error)
+
+// KanbanMoveFunc is fired when a user press 'm' character on a card.
+type KanbanMoveFunc func(card KanbanCard) func() (actions []string, handler KanbanMoveHandlerFunc)
+
+// Kanban is a kanban board layout.
+//
+// Each column of the board is a single column table.
+type Kanban struct {
+	screen       *Screen
+	painter      *tview.Pages
+	board        *tview.Flex
+	columns      []*tview.Table
+	footer       *tview.TextView
+	secondary    *tview.Modal
+	help         *primitive.InfoModal
+	action       *primitive.ActionModal
+	style        TableStyle
+	data         KanbanData
+	active       int
+	footerText   string
+	helpText     string
+	selectedFunc SelectedFunc
+	viewModeFunc ViewModeFunc
+	moveFunc     KanbanMoveFunc
+	refreshFunc  RefreshFunc
+	copyFunc     CopyFunc
+	copyKeyFunc  CopyKeyFunc
+}
+
+// KanbanOption is a functional option to wrap kanban properties.
+type KanbanOption func(*Kanban)
+
+// NewKanban constructs a new kanban layout.
+func NewKanban(opts ...KanbanOption) *Kanban {
+	tview.Styles.PrimitiveBackgroundColor = tcell.ColorDefault
+
+	kb := Kanban{
+		screen:    NewScreen(),
+		board:     tview.NewFlex(),
+		footer:    tview.NewTextView(),
+		help:      primitive.NewInfoModal(),
+		secondary: getInfoModal(),
+		action:    getActionModal(),
+	}
+	for _, opt := range opts {
+		opt(&kb)
+	}
+
+	kb.footer.
+		SetWordWrap(true).
+		SetText(pad(kb.footerText, 1)).
+		SetTextColor(tcell.ColorDefault)
+
+	kb.help.
+		SetInfo(kb.helpText).
+		SetAlign(tview.AlignLeft).
+		SetTitle("USAGE")
+
+	kb.help.SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
+		if ev.Key() == tcell.KeyEsc || (ev.Key() == tcell.KeyRune && ev.Rune() == 'q') {
+			kb.painter.HidePage("help")
+		}
+		return ev
+	})
+
+	kb.action.SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
+		if ev.Key() == tcell.KeyEsc || (ev.Key() == tcell.KeyRune && ev.Rune() == 'q') {
+			kb.painter.HidePage("action")
+		}
+		return ev
+	})
+
+	grid := tview.NewGrid().
+		SetRows(0, 1, 2).
+		AddItem(kb.board, 0, 0, 1, 1, 0, 0, true).
+		AddItem(tview.NewTextView(), 1, 0, 1, 1, 0, 0, false). // Dummy view to fake row padding.
+		AddItem(kb.footer, 2, 0, 1, 1, 0, 0, false)
+
+	kb.painter = tview.NewPages().
+		AddPage("primary", grid, true, true).
+		AddPage("secondary", kb.secondary, true, false).
+		AddPage("help", kb.help, true, false).
+		AddPage("action", kb.action, true, false)
+
+	return &kb
+}
+
+// WithKanbanStyle sets the style of the selected card.
+func WithKanbanStyle(style TableStyle) KanbanOption {
+	return func(k *Kanban) {
+		k.style = style
+	}
+}
+
+// WithKanbanFooterText sets footer text that is displayed after the board.
+func WithKanbanFooterText(text string) KanbanOption {
+	return func(k *Kanban) {
+		k.footerText = text
+	}
+}
+
+// WithKanbanHelpText sets the help text for the view.
+func WithKanbanHelpText(text string) KanbanOption {
+	return func(k *Kanban) {
+		k.helpText = text
+	}
+}
+
+// WithKanbanSelectedFunc sets a func that is triggered when a card is selected.
+// The selected card is passed as the data.
+func WithKanbanSelectedFunc(fn SelectedFunc) KanbanOption {
+	return func(k *Kanban) {
+		k.selectedFunc = fn
+	}
+}
+
+// WithKanbanViewModeFunc sets a func that is triggered when a user press 'v'.
+func WithKanbanViewModeFunc(fn ViewModeFunc) KanbanOption {
+	return func(k *Kanban) {
+		k.viewModeFunc = fn
+	}
+}
+
+// WithKanbanMoveFunc sets a func that is triggered when a user press 'm'.
+func WithKanbanMoveFunc(fn KanbanMoveFunc) KanbanOption {
+	return func(k *Kanban) {
+		k.moveFunc = fn
+	}
+}
+
+// WithKanbanRefreshFunc sets a func that is triggered when a user press 'CTRL+R' or 'F5'.
+func WithKanbanRefreshFunc(fn RefreshFunc) KanbanOption {
+	return func(k *Kanban) {
+		k.refreshFunc = fn
+	}
+}
+
+// WithKanbanCopyFunc sets a func that is triggered when a user press 'c'.
+func WithKanbanCopyFunc(fn CopyFunc) KanbanOption {
+	return func(k *Kanban) {
+		k.copyFunc = fn
+	}
+}
+
+// WithKanbanCopyKeyFunc sets a func that is triggered when a user press 'CTRL+K'.
+func WithKanbanCopyKeyFunc(fn CopyKeyFunc) KanbanOption {
+	return func(k *Kanban) {
+		k.copyKeyFunc = fn
+	}
+}
+
+// Paint paints the kanban layout.
+func (k *Kanban) Paint(data KanbanData) error {
+	if len(data) == 0 {
+		return errNoData
+	}
+	k.data = data
+	k.render()
+	return k.screen.Paint(k.painter)
+}
+
+func (k *Kanban) render() {
+	k.columns = make([]*tview.Table, 0, len(k.data))
+
+	for i, col := range k.data {
+		tbl := tview.NewTable().SetSelectable(true, false)
+		tbl.SetBorder(true).
+			SetTitle(k.columnTitle(col)).
+			SetTitleAlign(tview.AlignLeft)
+
+		if col.Max > 0 && len(col.Cards) > col.Max {
+			tbl.SetTitleColor(tcell.ColorRed)
+		}
+
+		for r, card := range col.Cards {
+			tbl.SetCell(r, 0, tview.NewTableCell(pad(card.Key, 1)).
+				SetStyle(tcell.StyleDefault.Bold(true)))
+			tbl.SetCell(r, 1, tview.NewTableCell(card.Summary).
+				SetTextColor(tcell.ColorDefault).
+				SetExpansion(1))
+		}
+
+		c := i
+		tbl.SetSelectedFunc(func(r, _ int) {
+			if k.selectedFunc == nil {
+				return
+			}
+			if card, ok := k.data.Get(c, r); ok {
+				k.selectedFunc(r, c, card)
+			}
+		})
+		tbl.SetDoneFunc(func(key tcell.Key) {
+			if key == tcell.KeyEsc {
+				k.screen.Stop()
+			}
+		})
+		tbl.SetInputCapture(k.inputCapture)
+
+		k.columns = append(k.columns, tbl)
+	}
+
+	k.focus(k.active)
+}
+
+func (k *Kanban) columnTitle(col *KanbanColumn) string {
+	if col.Max > 0 {
+		return fmt.Sprintf(" %s (%d/%d) ", col.Name, len(col.Cards), col.Max)
+	}
+	return fmt.Sprintf(" %s (%d) ", col.Name, len(col.Cards))
+}
+
+// focus moves the focus to the column at the given index.
+func (k *Kanban) focus(col int) {
+	if col < 0 || col >= len(k.columns) {
+		return
+	}
+	k.active = col
+
+	// Rebuild the board so that it delegates the focus to the active column.
+	k.board.Clear()
+	for i, tbl := range k.columns {
+		if i == col {
+			tbl.SetSelectedStyle(customTUIStyle(k.style)).SetBorderColor(tcell.ColorDarkCyan)
+		} else {
+			tbl.SetSelectedStyle(tcell.StyleDefault).SetBorderColor(tcell.ColorDefault)
+		}
+		k.board.AddItem(tbl, 0, 1, i == col)
+	}
+	k.screen.SetFocus(k.columns[col])
+}
+
+// selection returns the index of the active column and the selected row in it.
+func (k *Kanban) selection() (int, int) {
+	r, _ := k.columns[k.active].GetSelection()
+	return k.active, r
+}
+
+//nolint:gocyclo
+func (k *Kanban) inputCapture(ev *tcell.EventKey) *tcell.EventKey {
+	switch ev.Key() {
+	case tcell.KeyLeft:
+		k.focus(k.active - 1)
+		return nil
+	case tcell.KeyRight:
+		k.focus(k.active + 1)
+		return nil
+	case tcell.KeyCtrlR, tcell.KeyF5:
+		if k.refreshFunc == nil {
+			return ev
+		}
+		k.screen.Stop()
+		k.refreshFunc()
+	case tcell.KeyCtrlK:
+		if k.copyKeyFunc == nil {
+			return ev
+		}
+		c, r := k.selection()
+		if card, ok := k.data.Get(c, r); ok {
+			k.copyKeyFunc(r, c, card)
+		}
+	case tcell.KeyRune:
+		switch ev.Rune() {
+		case 'h':
+			k.focus(k.active - 1)
+			return nil
+		case 'l':
+			k.focus(k.active + 1)
+			return nil
+		case 'q':
+			k.screen.Stop()
+			os.Exit(0)
+		case '?':
+			k.painter.ShowPage("help")
+		case 'c':
+			if k.copyFunc == nil {
+				break
+			}
+			c, r := k.selection()
+			if card, ok := k.data.Get(c, r); ok {
+				k.copyFunc(r, c, card)
+			}
+		case 'v':
+			if k.viewModeFunc == nil {
+				break
+			}
+			c, r := k.selection()
+			card, ok := k.data.Get(c, r)
+			if !ok {
+				break
+			}
+
+			go func() {
+				func() {
+					k.painter.ShowPage("secondary")
+					defer k.painter.HidePage("secondary")
+
+					dataFn, renderFn := k.viewModeFunc(r, c, card)
+
+					out, err := renderFn(dataFn())
+					if err == nil {
+						k.screen.Suspend(func() { _ = PagerOut(out) })
+					}
+				}()
+
+				// Refresh the screen.
+				k.screen.Draw()
+			}()
+		case 'm':
+			if k.moveFunc == nil {
+				break
+			}
+			c, r := k.selection()
+			card, ok := k.data.Get(c, r)
+			if !ok {
+				break
+			}
+			k.move(c, r, card)
+		}
+	}
+	return ev
+}
+
+func (k *Kanban) move(col, row int, card KanbanCard) {
+	refreshContextInFooter := func() {
+		k.action.GetFooter().SetText("Use TAB or ← → to navigate, ENTER to select, ESC or q to cancel.").SetTextColor(tcell.ColorGray)
+	}
+
+	go func() {
+		func() {
+			k.painter.ShowPage("secondary").SendToFront("secondary")
+			defer func() {
+				k.painter.HidePage("secondary")
+				k.painter.ShowPage("action")
+			}()
+			refreshContextInFooter()
+
+			actions, handler := k.moveFunc(card)()
+
+			k.action.ClearButtons().AddButtons(actions).SetFocus(0)
+			k.action.SetText(
+				fmt.Sprintf("Select desired state to transition %s to:", card.Key),
+			)
+
+			k.action.SetDoneFunc(func(_ int, btnLabel string) {
+				k.action.GetFooter().SetText("Processing. Please wait...").SetTextColor(tcell.ColorGray)
+				k.screen.ForceDraw()
+
+				to, err := handler(btnLabel)
+				if err != nil {
+					k.action.GetFooter().SetText(
+						fmt.Sprintf("Error: %s", err.Error()),
+					).SetTextColor(tcell.ColorRed)
+					return
+				}
+				k.painter.HidePage("action")
+				refreshContextInFooter()
+
+				// Follow the card to its new column.
+				if target := k.data.Move(col, row, to); target != -1 {
+					k.active = target
+				}
+				k.render()
+				if n := len(k.data[k.active].Cards); n > 0 {
+					k.columns[k.active].Select(n-1, 0)
+				}
+			})
+		}()
+
+		// Refresh the screen.
+		k.screen.Draw()
+	}()
+}
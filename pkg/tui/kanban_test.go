@@ -0,0 +1,57 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKanbanDataMove(t *testing.T) {
+	data := func() KanbanData {
+		return KanbanData{
+			{Name: "To Do", Cards: []KanbanCard{{Key: "TEST-1"}, {Key: "TEST-2"}}},
+			{Name: "In Progress", Cards: []KanbanCard{{Key: "TEST-3"}}},
+			{Name: "Done"},
+		}
+	}
+
+	kd := data()
+	assert.Equal(t, 2, kd.Move(0, 1, "Done"))
+	assert.Equal(t, []KanbanCard{{Key: "TEST-1"}}, kd[0].Cards)
+	assert.Equal(t, []KanbanCard{{Key: "TEST-2"}}, kd[2].Cards)
+
+	kd = data()
+	assert.Equal(t, 0, kd.Move(1, 0, "To Do"))
+	assert.Empty(t, kd[1].Cards)
+	assert.Equal(t, []KanbanCard{{Key: "TEST-1"}, {Key: "TEST-2"}, {Key: "TEST-3"}}, kd[0].Cards)
+
+	// The card leaves the board if the column doesn't exist.
+	kd = data()
+	assert.Equal(t, -1, kd.Move(0, 0, "Closed"))
+	assert.Equal(t, []KanbanCard{{Key: "TEST-2"}}, kd[0].Cards)
+	assert.Len(t, kd[1].Cards, 1)
+	assert.Empty(t, kd[2].Cards)
+
+	// Invalid positions are ignored.
+	kd = data()
+	assert.Equal(t, -1, kd.Move(2, 0, "To Do"))
+	assert.Equal(t, -1, kd.Move(3, 0, "To Do"))
+	assert.Equal(t, data(), kd)
+}
+
+func TestKanbanDataGet(t *testing.T) {
+	kd := KanbanData{
+		{Name: "To Do", Cards: []KanbanCard{{Key: "TEST-1"}}},
+		{Name: "Done"},
+	}
+
+	card, ok := kd.Get(0, 0)
+	assert.True(t, ok)
+	assert.Equal(t, "TEST-1", card.Key)
+
+	_, ok = kd.Get(1, 0)
+	assert.False(t, ok)
+
+	_, ok = kd.Get(-1, 0)
+	assert.False(t, ok)
+}
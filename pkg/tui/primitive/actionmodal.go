@@ -137,6 +137,32 @@ func (m *ActionModal) AddButtons(labels []string) *ActionModal {
 	return m
 }
 
+// SetInput adds a text input with the given label to the window, replacing the
+// existing one if any. An empty label removes the input from the window.
+func (m *ActionModal) SetInput(label string) *ActionModal {
+	m.form.Clear(false)
+	if label != "" {
+		m.form.AddInputField(label, "", 0, nil, nil)
+	}
+	return m
+}
+
+// HasInput returns whether or not the window has a text input.
+func (m *ActionModal) HasInput() bool {
+	return m.form.GetFormItemCount() > 0
+}
+
+// GetInputText returns the text entered in the text input of the window.
+func (m *ActionModal) GetInputText() string {
+	if !m.HasInput() {
+		return ""
+	}
+	if input, ok := m.form.GetFormItem(0).(*tview.InputField); ok {
+		return input.GetText()
+	}
+	return ""
+}
+
 // ClearButtons removes all buttons from the window.
 func (m *ActionModal) ClearButtons() *ActionModal {
 	m.form.ClearButtons()
@@ -193,7 +219,7 @@ func (m *ActionModal) Draw(screen tcell.Screen) {
 	}
 
 	// Set the modal's position and size.
-	height := len(lines) + 9
+	height := len(lines) + 9 + 2*m.form.GetFormItemCount()
 	width += 4
 	x := (screenWidth - width) / 2
 	y := (screenHeight - height) / 2
@@ -0,0 +1,140 @@
+package tui
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	sortAscIndicator  = "▲"
+	sortDescIndicator = "▼"
+)
+
+// showSort asks for the column to sort the rows by.
+func (t *Table) showSort() {
+	if len(t.data) < 2 || t.bulkRunning {
+		return
+	}
+
+	list := tview.NewList().ShowSecondaryText(false)
+	list.SetBorder(true).SetTitle(" Sort by ").SetBorderPadding(0, 0, 1, 1)
+
+	width := 0
+	for c, h := range t.data[0] {
+		label := h
+		if c == t.sortCol {
+			label += " " + t.sortIndicator()
+		}
+		width = max(width, tview.TaggedStringWidth(label))
+
+		col := c
+		list.AddItem(label, "", 0, func() {
+			t.painter.RemovePage("sort")
+			t.sortBy(col)
+		})
+	}
+	list.SetCurrentItem(max(t.sortCol, 0))
+	list.SetDoneFunc(func() {
+		t.painter.RemovePage("sort")
+	})
+	list.SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
+		if ev.Key() == tcell.KeyRune && ev.Rune() == 'q' {
+			t.painter.RemovePage("sort")
+			return nil
+		}
+		return ev
+	})
+
+	//nolint:mnd
+	modal := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(
+			tview.NewFlex().SetDirection(tview.FlexRow).
+				AddItem(nil, 0, 1, false).
+				AddItem(list, len(t.data[0])+2, 0, true).
+				AddItem(nil, 0, 1, false),
+			width+6, 0, true,
+		).
+		AddItem(nil, 0, 1, false)
+
+	t.painter.AddPage("sort", modal, true, true).SendToFront("sort")
+}
+
+// sortBy sorts the rows by the column. Sorting by the same column again
+// reverses the order. Empty values are always placed at the end.
+func (t *Table) sortBy(col int) {
+	if len(t.data) < 2 || col < 0 || col >= len(t.data[0]) {
+		return
+	}
+
+	desc := col == t.sortCol && !t.sortDesc
+
+	order := make([]int, 0, len(t.data)-1)
+	for r := 1; r < len(t.data); r++ {
+		order = append(order, r)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := t.data[order[i]][col], t.data[order[j]][col]
+		if a == "" || b == "" {
+			return a != "" && b == ""
+		}
+		if desc {
+			return compareCells(b, a) < 0
+		}
+		return compareCells(a, b) < 0
+	})
+
+	// Rows are reordered in place so that the references to the data stay valid.
+	var (
+		rows = make([][]string, len(order))
+		pos  = make(map[int]int, len(order))
+	)
+	for i, r := range order {
+		rows[i] = t.data[r]
+		pos[r] = i + 1
+	}
+	copy(t.data[1:], rows)
+
+	marked := make(map[int]struct{}, len(t.marked))
+	for r := range t.marked {
+		marked[pos[r]] = struct{}{}
+	}
+	removed := make(map[int]struct{}, len(t.removed))
+	for r := range t.removed {
+		removed[pos[r]] = struct{}{}
+	}
+	t.marked, t.removed = marked, removed
+	t.sortCol, t.sortDesc = col, desc
+
+	renderTableHeader(t, t.data[0])
+	renderTableCell(t, t.data)
+	t.view.Select(1, 0)
+}
+
+func (t *Table) sortIndicator() string {
+	if t.sortDesc {
+		return sortDescIndicator
+	}
+	return sortAscIndicator
+}
+
+// compareCells compares the cells numerically if both of them are numbers,
+// otherwise they are compared as case-insensitive strings.
+func compareCells(a, b string) int {
+	x, errA := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	y, errB := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	if errA == nil && errB == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
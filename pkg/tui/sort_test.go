@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableSortBy(t *testing.T) {
+	tbl := NewTable(WithBulkActions(BulkAction{Name: "Delete"}))
+	tbl.data = TableData{
+		{"KEY", "STORY POINTS", "ASSIGNEE"},
+		{"TEST-1", "5", "Person B"},
+		{"TEST-2", "13", ""},
+		{"TEST-3", "", "person a"},
+		{"TEST-4", "2", "Person C"},
+	}
+	tbl.render(tbl.data)
+
+	// References to the data, ie: rows, should stay valid after sorting.
+	data := tbl.data
+	tbl.toggleMark(1)
+	tbl.removed[4] = struct{}{}
+
+	// Numbers are compared numerically and empty values are placed at the end.
+	tbl.sortBy(1)
+	assert.Equal(t, TableData{
+		{"KEY", "STORY POINTS", "ASSIGNEE"},
+		{"TEST-4", "2", "Person C"},
+		{"TEST-1", "5", "Person B"},
+		{"TEST-2", "13", ""},
+		{"TEST-3", "", "person a"},
+	}, data)
+	assert.Equal(t, " STORY POINTS ▲", tbl.view.GetCell(0, 1).Text)
+	assert.Equal(t, map[int]struct{}{2: {}}, tbl.marked)
+	assert.Equal(t, map[int]struct{}{1: {}}, tbl.removed)
+
+	// Sorting by the same column again reverses the order.
+	tbl.sortBy(1)
+	assert.Equal(t, TableData{
+		{"KEY", "STORY POINTS", "ASSIGNEE"},
+		{"TEST-2", "13", ""},
+		{"TEST-1", "5", "Person B"},
+		{"TEST-4", "2", "Person C"},
+		{"TEST-3", "", "person a"},
+	}, data)
+	assert.Equal(t, " STORY POINTS ▼", tbl.view.GetCell(0, 1).Text)
+	assert.Equal(t, map[int]struct{}{2: {}}, tbl.marked)
+	assert.Equal(t, map[int]struct{}{3: {}}, tbl.removed)
+
+	// Strings are compared case-insensitively.
+	tbl.sortBy(2)
+	assert.Equal(t, TableData{
+		{"KEY", "STORY POINTS", "ASSIGNEE"},
+		{"TEST-3", "", "person a"},
+		{"TEST-1", "5", "Person B"},
+		{"TEST-4", "2", "Person C"},
+		{"TEST-2", "13", ""},
+	}, data)
+	assert.Equal(t, " STORY POINTS", tbl.view.GetCell(0, 1).Text)
+	assert.Equal(t, " ASSIGNEE ▲", tbl.view.GetCell(0, 2).Text)
+}
+
+func TestCompareCells(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "2", b: "13", expected: -1},
+		{a: "13", b: "2.5", expected: 1},
+		{a: "5", b: "5.0", expected: 0},
+		{a: "2020-12-13 14:05:20", b: "2021-01-01 10:00:00", expected: -1},
+		{a: "beta", b: "Alpha", expected: 1},
+		{a: "10", b: "Alpha", expected: -1},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, compareCells(tc.a, tc.b), "%s <=> %s", tc.a, tc.b)
+	}
+}
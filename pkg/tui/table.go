@@ -102,6 +102,12 @@ type Table struct {
 	refreshFunc  RefreshFunc
 	copyFunc     CopyFunc
 	copyKeyFunc  CopyKeyFunc
+	bulkActions  []BulkAction
+	bulkRunning  bool
+	marked       map[int]struct{}
+	removed      map[int]struct{}
+	sortCol      int
+	sortDesc     bool
 }
 
 // TableOption is a functional option to wrap table properties.
@@ -120,6 +126,9 @@ func NewTable(opts ...TableOption) *Table {
 		action:      getActionModal(),
 		colPad:      defaultColPad,
 		maxColWidth: defaultColWidth,
+		marked:      make(map[int]struct{}),
+		removed:     make(map[int]struct{}),
+		sortCol:     -1,
 	}
 	for _, opt := range opts {
 		opt(&tbl)
@@ -136,7 +145,7 @@ func NewTable(opts ...TableOption) *Table {
 		AddItem(tbl.footer, 2, 0, 1, 1, 0, 0, false)
 
 	tbl.action.SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
-		if ev.Key() == tcell.KeyEsc || (ev.Key() == tcell.KeyRune && ev.Rune() == 'q') {
+		if ev.Key() == tcell.KeyEsc || (ev.Key() == tcell.KeyRune && ev.Rune() == 'q' && !tbl.action.HasInput()) {
 			tbl.painter.HidePage("action")
 		}
 		return ev
@@ -279,6 +288,21 @@ func (t *Table) initTable() {
 				t.screen.Stop()
 				t.refreshFunc()
 			}
+			if ev.Modifiers()&tcell.ModShift != 0 && (ev.Key() == tcell.KeyDown || ev.Key() == tcell.KeyUp) {
+				r, c := t.view.GetSelection()
+				t.mark(r)
+
+				if ev.Key() == tcell.KeyDown && r < len(t.data)-1 {
+					r++
+				}
+				if ev.Key() == tcell.KeyUp && r > 1 {
+					r--
+				}
+				t.mark(r)
+				t.view.Select(r, c)
+
+				return nil
+			}
 			if ev.Key() == tcell.KeyCtrlK {
 				if t.copyKeyFunc == nil {
 					return ev
@@ -293,6 +317,20 @@ func (t *Table) initTable() {
 					os.Exit(0)
 				case '?':
 					t.painter.ShowPage("help")
+				case ' ':
+					if len(t.bulkActions) == 0 {
+						break
+					}
+					r, c := t.view.GetSelection()
+					t.toggleMark(r)
+					if r < len(t.data)-1 {
+						t.view.Select(r+1, c)
+					}
+					return nil
+				case 'b':
+					t.bulk()
+				case 's':
+					t.showSort()
 				case 'c':
 					if t.copyFunc == nil {
 						break
@@ -351,7 +389,7 @@ func (t *Table) initTable() {
 								return 0
 							}
 
-							t.action.ClearButtons().AddButtons(actions).SetFocus(currentStatusIdx())
+							t.action.SetInput("").ClearButtons().AddButtons(actions).SetFocus(currentStatusIdx())
 							t.action.SetText(
 								fmt.Sprintf("Select desired state to transition %s to:", key),
 							)
@@ -393,6 +431,9 @@ func renderTableHeader(t *Table, data []string) {
 
 	for c := 0; c < len(data); c++ {
 		text := " " + data[c]
+		if c == t.sortCol {
+			text += " " + t.sortIndicator()
+		}
 
 		cell := tview.NewTableCell(text).
 			SetStyle(style).
@@ -415,5 +456,6 @@ func renderTableCell(t *Table, data TableData) {
 
 			t.view.SetCell(r, c, cell)
 		}
+		t.paintRow(r)
 	}
 }